@@ -4,3 +4,8 @@
 data "altr_access_management_oltp_policy" "example" {
   id = "OLTP#20b8b5df-3b72-4a24-9dc5-cf854ba81f07#POLICY"
 }
+
+data "altr_access_management_oltp_policy" "by_name" {
+  name      = "example"
+  repo_name = "example"
+}
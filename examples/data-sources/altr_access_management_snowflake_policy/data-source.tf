@@ -4,3 +4,7 @@
 data "altr_access_management_snowflake_policy" "example" {
   id = "GRANT#20b8b5df-3b72-4a24-9dc5-cf854ba81f07#POLICY"
 }
+
+data "altr_access_management_snowflake_policy" "by_name" {
+  name = "example"
+}
@@ -3,3 +3,7 @@
 data "altr_agent" "example" {
   id = "00000000-0000-4000-8000-000000000000"
 }
+
+data "altr_agent" "by_name" {
+  name = "classifier-prod"
+}
@@ -0,0 +1,15 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+data "altr_repos" "all" {}
+
+# Tasks of every agent in the organization
+data "altr_agent_tasks" "all" {}
+
+# Repositories that no classification task runs against
+output "unclassified_repos" {
+  value = setsubtract(
+    data.altr_repos.all.names,
+    data.altr_agent_tasks.all.tasks[*].repo_name,
+  )
+}
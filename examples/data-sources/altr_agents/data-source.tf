@@ -0,0 +1,7 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+data "altr_agents" "classifiers" {
+  type       = "CLASSIFIER"
+  name_regex = "^prod-"
+}
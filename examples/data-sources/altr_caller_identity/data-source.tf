@@ -0,0 +1,20 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+data "altr_caller_identity" "current" {}
+
+output "altr_org_id" {
+  value = data.altr_caller_identity.current.org_id
+}
+
+resource "altr_sidecar" "example" {
+  name     = "example"
+  hostname = "example.com"
+
+  lifecycle {
+    precondition {
+      condition     = data.altr_caller_identity.current.org_id == "org-id"
+      error_message = "Sidecars must be created in the production organization."
+    }
+  }
+}
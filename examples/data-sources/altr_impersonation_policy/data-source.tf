@@ -5,3 +5,7 @@ data "altr_impersonation_policy" "example" {
   id = "IMPERSONATION#20b8b5df-3b72-4a24-9dc5-cf854ba81f07#POLICY"
 }
 
+data "altr_impersonation_policy" "by_name" {
+  name      = "example"
+  repo_name = "example"
+}
@@ -0,0 +1,15 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+data "altr_policies" "example" {
+  repo_name = "example"
+}
+
+# Bring policies created in the UI under Terraform, e.g.
+#   terraform plan -generate-config-out=policies.tf
+output "example_policy_imports" {
+  value = [
+    for policy in data.altr_policies.example.policies :
+    "import {\n  to = ${policy.resource_type}.${policy.name}\n  id = \"${policy.id}\"\n}"
+  ]
+}
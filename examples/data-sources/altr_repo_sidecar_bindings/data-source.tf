@@ -0,0 +1,11 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+data "altr_repo_sidecar_bindings" "example" {
+  repo_name = "example"
+}
+
+# Sidecar ports that front the repository
+output "example_repo_ports" {
+  value = data.altr_repo_sidecar_bindings.example.bindings[*].port
+}
@@ -0,0 +1,11 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+data "altr_repo_users" "env_credentials" {
+  repo_name           = "example"
+  credential_provider = "environment_variable"
+}
+
+output "users_on_environment_variables" {
+  value = data.altr_repo_users.env_credentials.usernames
+}
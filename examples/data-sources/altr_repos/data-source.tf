@@ -0,0 +1,32 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+data "altr_repos" "postgres" {
+  type = "Postgres"
+}
+
+resource "altr_impersonation_policy" "postgres" {
+  for_each = toset(data.altr_repos.postgres.names)
+
+  name      = "${each.key}_impersonation"
+  repo_name = each.key
+
+  rules = [
+    {
+      actors = [
+        {
+          type        = "idp_user"
+          identifiers = ["admin@example.com"]
+          condition   = "equals"
+        }
+      ]
+      targets = [
+        {
+          type        = "repo_user"
+          identifiers = ["app_user"]
+          condition   = "equals"
+        }
+      ]
+    }
+  ]
+}
@@ -0,0 +1,10 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+data "altr_service_users" "example" {
+  repo_name = "example"
+}
+
+output "service_user_credential_providers" {
+  value = { for u in data.altr_service_users.example.service_users : u.username => u.credential_provider }
+}
@@ -4,3 +4,7 @@
 data "altr_sidecar" "example" {
   id = "682bfaa1-77e1-40aa-897f-1a0469f4ac64"
 }
+
+data "altr_sidecar" "by_name" {
+  name = "prod-us-east"
+}
@@ -0,0 +1,18 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+data "altr_sidecar" "example" {
+  name = "example"
+}
+
+data "altr_sidecar_listeners" "example" {
+  sidecar_id = data.altr_sidecar.example.id
+}
+
+# Connection endpoints for every listener on the sidecar
+output "example_listener_endpoints" {
+  value = {
+    for listener in data.altr_sidecar_listeners.example.listeners :
+    listener.port => "${data.altr_sidecar.example.hostname}:${listener.port} (${listener.database_type})"
+  }
+}
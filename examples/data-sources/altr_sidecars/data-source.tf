@@ -0,0 +1,10 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+data "altr_sidecars" "prod" {
+  name_regex = "^prod-us-east"
+}
+
+output "prod_sidecar_ids" {
+  value = data.altr_sidecars.prod.ids
+}
@@ -0,0 +1,30 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+# Look up a connection configured in the ALTR UI
+data "altr_snowflake_connection" "legacy" {
+  name = "legacy"
+}
+
+resource "altr_access_management_snowflake_policy" "legacy_readers" {
+  name           = "legacy_readers"
+  connection_ids = [data.altr_snowflake_connection.legacy.id]
+
+  rules = [
+    {
+      actors = [{
+        type        = "role"
+        identifiers = ["ANALYST"]
+        condition   = "equals"
+      }],
+      objects = [{
+        type        = "database"
+        identifiers = ["LEGACY"]
+        condition   = "equals"
+      }],
+      access = [{
+        name = "read"
+      }]
+    }
+  ]
+}
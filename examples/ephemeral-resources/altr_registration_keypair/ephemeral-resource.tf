@@ -0,0 +1,21 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+ephemeral "altr_registration_keypair" "sidecar" {
+  key_size = 4096
+}
+
+# Register the public key with ALTR without storing it in state.
+resource "altr_sidecar" "example" {
+  name                  = "example"
+  hostname              = "example.com"
+  public_key_1_wo       = ephemeral.altr_registration_keypair.sidecar.public_key_pem
+  public_key_wo_version = 1
+}
+
+# Hand the private key to the sidecar host through a write-only secret.
+resource "aws_secretsmanager_secret_version" "sidecar_private_key" {
+  secret_id                = aws_secretsmanager_secret.sidecar_private_key.id
+  secret_string_wo         = ephemeral.altr_registration_keypair.sidecar.private_key_pem
+  secret_string_wo_version = 1
+}
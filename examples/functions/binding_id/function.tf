@@ -0,0 +1,7 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+import {
+  to = altr_repo_sidecar_binding.example
+  id = provider::altr::binding_id(var.sidecar_id, 5432, "example")
+}
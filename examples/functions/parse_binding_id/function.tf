@@ -0,0 +1,6 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+output "binding_port" {
+  value = provider::altr::parse_binding_id(altr_repo_sidecar_binding.example.id).port
+}
@@ -0,0 +1,7 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+output "orders_table" {
+  # MY_DB.PUBLIC.ORDERS
+  value = provider::altr::snowflake_fqn("MY_DB", "PUBLIC", "ORDERS")
+}
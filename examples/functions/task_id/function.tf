@@ -0,0 +1,7 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+import {
+  to = altr_agent_task.example
+  id = provider::altr::task_id(var.agent_id, var.task_id)
+}
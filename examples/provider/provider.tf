@@ -16,4 +16,7 @@ provider "altr" {
   base_url = "https://org-id.altrnet.live.altr.com"
   org_id   = "org-id"
   secret   = "api-secret"
+
+  # Fail during provider configuration if the credentials are rejected
+  validate_credentials = true
 }
@@ -4,7 +4,7 @@
 resource "altr_access_management_snowflake_policy" "example" {
   name           = "example"
   description    = "Example access management policy"
-  connection_ids = [1]
+  connection_ids = [altr_snowflake_connection.prod.id]
 
   rules = [
     {
@@ -0,0 +1,21 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+resource "altr_agent" "example" {
+  type         = "CLASSIFIER"
+  name         = "example"
+  public_key_1 = file("${path.module}/agent-2024.pub")
+
+  lifecycle {
+    ignore_changes = [public_key_1, public_key_2]
+  }
+}
+
+# 1. Point public_key at the new key and apply: it is registered next to the current key.
+# 2. Deploy the new private key to every agent host.
+# 3. Set confirmed_fingerprint to the new public_key_fingerprint and apply to retire the old key.
+resource "altr_agent_key_rotation" "example" {
+  agent_id              = altr_agent.example.id
+  public_key            = file("${path.module}/agent-2025.pub")
+  confirmed_fingerprint = "3674c2a4f8cc5fc757b87dc1c8e7be9ad35f7ea1a369a4209894d0a684b85671"
+}
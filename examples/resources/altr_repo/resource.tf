@@ -6,4 +6,39 @@ resource "altr_repo" "example" {
   type     = "Oracle"
   hostname = "example.com"
   port     = 1521
+
+  connection_options = {
+    oracle = {
+      service_name = "ORCLPDB1"
+    }
+  }
+}
+
+resource "altr_repo" "postgres" {
+  name     = "orders"
+  type     = "Postgres"
+  hostname = "orders.example.com"
+
+  connection_options = {
+    postgres = {
+      database = "orders"
+      sslmode  = "verify-full"
+    }
+  }
+}
+
+# Warehouse repositories take their port from the type when it is omitted
+resource "altr_repo" "snowflake" {
+  name            = "analytics"
+  type            = "Snowflake"
+  hostname        = "xy12345.us-east-1.snowflakecomputing.com"
+  account_locator = "xy12345.us-east-1"
+  warehouse       = "COMPUTE_WH"
+}
+
+resource "altr_repo" "databricks" {
+  name      = "lakehouse"
+  type      = "Databricks"
+  hostname  = "dbc-a1b2c3d4-e5f6.cloud.databricks.com"
+  http_path = "/sql/1.0/warehouses/1234567890abcdef"
 }
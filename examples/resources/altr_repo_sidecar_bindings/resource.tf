@@ -0,0 +1,48 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+resource "altr_sidecar" "primary" {
+  name     = "primary"
+  hostname = "primary.example.com"
+}
+
+resource "altr_sidecar" "secondary" {
+  name     = "secondary"
+  hostname = "secondary.example.com"
+}
+
+resource "altr_sidecar_listener" "primary" {
+  sidecar_id         = altr_sidecar.primary.id
+  port               = 1521
+  database_type      = "Oracle"
+  advertised_version = "19.0.0.0"
+}
+
+resource "altr_sidecar_listener" "secondary" {
+  sidecar_id         = altr_sidecar.secondary.id
+  port               = 1521
+  database_type      = "Oracle"
+  advertised_version = "19.0.0.0"
+}
+
+resource "altr_repo" "example" {
+  name     = "example"
+  type     = "Oracle"
+  hostname = "example.com"
+}
+
+# The repo is reachable only through these two sidecar ports; any other binding is removed
+resource "altr_repo_sidecar_bindings" "example" {
+  repo_name = altr_repo.example.name
+
+  bindings = [
+    {
+      sidecar_id = altr_sidecar.primary.id
+      port       = altr_sidecar_listener.primary.port
+    },
+    {
+      sidecar_id = altr_sidecar.secondary.id
+      port       = altr_sidecar_listener.secondary.port
+    },
+  ]
+}
@@ -0,0 +1,33 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+resource "altr_sidecar" "example" {
+  name     = "example"
+  hostname = "sidecar.example.com"
+}
+
+# Replacing the certificate files rotates the certificate in place
+resource "altr_sidecar_certificate" "example" {
+  sidecar_id        = altr_sidecar.example.id
+  name              = "sidecar-example-com"
+  certificate_chain = file("${path.module}/certs/sidecar.example.com.chain.pem")
+  private_key_wo    = file("${path.module}/certs/sidecar.example.com.key")
+}
+
+resource "altr_sidecar_listener" "postgres" {
+  sidecar_id         = altr_sidecar.example.id
+  port               = 5432
+  database_type      = "Postgres"
+  advertised_version = "16.2"
+
+  tls = {
+    enabled        = true
+    required       = true
+    min_version    = "1.3"
+    certificate_id = altr_sidecar_certificate.example.id
+  }
+}
+
+output "certificate_expires_at" {
+  value = altr_sidecar_certificate.example.expires_at
+}
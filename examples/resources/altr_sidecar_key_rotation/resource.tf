@@ -0,0 +1,21 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+resource "altr_sidecar" "example" {
+  name         = "example"
+  hostname     = "example.com"
+  public_key_1 = file("${path.module}/sidecar-2024.pub")
+
+  lifecycle {
+    ignore_changes = [public_key_1, public_key_2]
+  }
+}
+
+# 1. Point public_key at the new key and apply: it is registered next to the current key.
+# 2. Deploy the new private key to every sidecar host.
+# 3. Set confirmed_fingerprint to the new public_key_fingerprint and apply to retire the old key.
+resource "altr_sidecar_key_rotation" "example" {
+  sidecar_id            = altr_sidecar.example.id
+  public_key            = file("${path.module}/sidecar-2025.pub")
+  confirmed_fingerprint = "3674c2a4f8cc5fc757b87dc1c8e7be9ad35f7ea1a369a4209894d0a684b85671"
+}
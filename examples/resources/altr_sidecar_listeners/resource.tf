@@ -0,0 +1,25 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+resource "altr_sidecar" "example" {
+  name     = "example"
+  hostname = "example.com"
+}
+
+# Owns every listener of the sidecar; ports registered elsewhere are removed
+resource "altr_sidecar_listeners" "example" {
+  sidecar_id = altr_sidecar.example.id
+
+  listeners = [
+    {
+      port               = 1521
+      database_type      = "Oracle"
+      advertised_version = "19.0.0.0"
+    },
+    {
+      port               = 5432
+      database_type      = "Postgres"
+      advertised_version = "16.2"
+    },
+  ]
+}
@@ -0,0 +1,35 @@
+# Copyright (c) ALTR Solutions, Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+resource "altr_snowflake_connection" "prod" {
+  name      = "prod"
+  account   = "myorg-prod"
+  role      = "ALTR_SERVICE_ROLE"
+  warehouse = "ALTR_WH"
+
+  key_pair = {
+    username = "ALTR_SERVICE"
+    private_key = {
+      aws_secrets_manager = {
+        secrets_path = "altr/snowflake/prod/private_key"
+      }
+    }
+  }
+}
+
+resource "altr_snowflake_connection" "analytics" {
+  name      = "analytics"
+  account   = "myorg-analytics"
+  role      = "ALTR_SERVICE_ROLE"
+  warehouse = "ALTR_WH"
+
+  oauth = {
+    client_id = "altr-governance"
+    client_secret = {
+      azure_key_vault = {
+        key_vault_uri = "https://altr-secrets.vault.azure.net/"
+        secret_name   = "snowflake-analytics-client-secret"
+      }
+    }
+  }
+}
@@ -11,15 +11,16 @@ import (
 
 // AccessManagementSnowflakePolicy represents an access management Snowflake policy
 type AccessManagementSnowflakePolicy struct {
-	ID           string                          `json:"policy_id"`
-	Name         string                          `json:"policy_name"`
-	Description  string                          `json:"description"`
-	CreatedAt    string                          `json:"created_at"`
-	UpdatedAt    string                          `json:"updated_at"`
-	Rules        []AccessManagementSnowflakeRule `json:"rules"` // This is for the POST, the other rule arrays are for GET
-	PendingRules []AccessManagementSnowflakeRule `json:"rules_pending"`
-	AppliedRules []AccessManagementSnowflakeRule `json:"rules_applied"`
-	FailedRules  []AccessManagementSnowflakeRule `json:"rules_failed"`
+	ID                string                             `json:"policy_id"`
+	Name              string                             `json:"policy_name"`
+	Description       string                             `json:"description"`
+	CreatedAt         string                             `json:"created_at"`
+	UpdatedAt         string                             `json:"updated_at"`
+	Rules             []AccessManagementSnowflakeRule    `json:"rules"` // This is for the POST, the other rule arrays are for GET
+	PendingRules      []AccessManagementSnowflakeRule    `json:"rules_pending"`
+	AppliedRules      []AccessManagementSnowflakeRule    `json:"rules_applied"`
+	FailedRules       []AccessManagementSnowflakeRule    `json:"rules_failed"`
+	PolicyMaintenance *AccessManagementPolicyMaintenance `json:"policy_maintenance,omitempty"`
 }
 
 type AccessManagementSnowflakeRule struct {
@@ -82,9 +83,10 @@ type CreateAccessManagementSnowflakePolicyInput struct {
 
 // UpdateAccessManagementSnowflakePolicyInput represents the input for updating an access management Snowflake policy
 type UpdateAccessManagementSnowflakePolicyInput struct {
-	Name        string                          `json:"name"`
-	Description string                          `json:"description"`
-	Rules       []AccessManagementSnowflakeRule `json:"rules"`
+	Name              string                             `json:"name"`
+	Description       string                             `json:"description"`
+	Rules             []AccessManagementSnowflakeRule    `json:"rules"`
+	PolicyMaintenance *AccessManagementPolicyMaintenance `json:"policy_maintenance,omitempty"`
 }
 
 // CreateAccessManagementSnowflakePolicy creates a new access management Snowflake policy
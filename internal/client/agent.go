@@ -43,6 +43,21 @@ func (c *Client) GetAgent(agentID string) (*Agent, error) {
 	return &agent, nil
 }
 
+// ListAgents lists all agents in the organization
+func (c *Client) ListAgents() ([]Agent, error) {
+	resp, err := c.makeRequest(http.MethodGet, "/agents", nil, "sidecar")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list agents: %w", err)
+	}
+
+	var output ListAgentsOutput
+	if err := handleAPIResponse(resp, &output); err != nil {
+		return nil, fmt.Errorf("failed to list agents: %w", err)
+	}
+
+	return output.Agents, nil
+}
+
 // UpdateAgent updates an existing agent
 func (c *Client) UpdateAgent(agentID string, input UpdateAgentInput) (*Agent, error) {
 	resp, err := c.makeRequest(http.MethodPatch, "/agents/"+url.PathEscape(agentID), input, "sidecar")
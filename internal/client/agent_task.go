@@ -43,6 +43,30 @@ func (c *Client) GetAgentTask(agentID, taskID string) (*AgentTask, error) {
 	return &task, nil
 }
 
+// ListAgentTasks lists all tasks of an agent
+func (c *Client) ListAgentTasks(agentID string) ([]AgentTask, error) {
+	resp, err := c.makeRequest(http.MethodGet, fmt.Sprintf("/agents/%s/tasks", url.PathEscape(agentID)), nil, "sidecar")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list agent tasks: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	var output ListAgentTasksOutput
+	if err := handleAPIResponse(resp, &output); err != nil {
+		return nil, fmt.Errorf("failed to list agent tasks: %w", err)
+	}
+
+	// An agent without tasks is not the same as a missing agent
+	if output.Tasks == nil {
+		return []AgentTask{}, nil
+	}
+
+	return output.Tasks, nil
+}
+
 // UpdateAgentTask updates an existing agent task
 func (c *Client) UpdateAgentTask(agentID, taskID string, input UpdateAgentTaskInput) (*AgentTask, error) {
 	resp, err := c.makeRequest(http.MethodPatch, fmt.Sprintf("/agents/%s/tasks/%s", url.PathEscape(agentID), url.PathEscape(taskID)), input, "sidecar")
@@ -20,6 +20,8 @@ import (
 
 type Client struct {
 	httpClient  *http.Client
+	orgID       string
+	apiKey      string
 	baseURL     string
 	externalURL string // URL for external API calls
 	sidecarURL  string // URL for sidecar API calls
@@ -39,6 +41,8 @@ func NewClient(orgID, apiKey, secret, baseURL string) (*Client, error) {
 
 		return &Client{
 			httpClient:  &http.Client{Timeout: 30 * time.Second},
+			orgID:       orgID,
+			apiKey:      apiKey,
 			baseURL:     baseURL,
 			externalURL: externalURL, // For altrnet, external and sidecar URLs
 			sidecarURL:  sidecarURL,
@@ -49,6 +53,52 @@ func NewClient(orgID, apiKey, secret, baseURL string) (*Client, error) {
 	}
 }
 
+// OrgID returns the organization the client authenticates against
+func (c *Client) OrgID() string {
+	return c.orgID
+}
+
+// APIKeyID returns the ID of the API key the client authenticates with
+func (c *Client) APIKeyID() string {
+	return c.apiKey
+}
+
+// BaseURL returns the resolved altrnet base URL
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+// ExternalURL returns the resolved URL of the external API gateway
+func (c *Client) ExternalURL() string {
+	return c.externalURL
+}
+
+// SidecarURL returns the resolved URL of the sidecar control API gateway
+func (c *Client) SidecarURL() string {
+	return c.sidecarURL
+}
+
+// ValidateCredentials makes a cheap authenticated call to check that the API
+// key and secret are accepted
+func (c *Client) ValidateCredentials() error {
+	resp, err := c.makeRequest(http.MethodGet, "/sidecars", nil, "sidecar")
+	if err != nil {
+		return fmt.Errorf("failed to validate credentials: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		_ = resp.Body.Close()
+
+		return fmt.Errorf("credentials were rejected by %s (status %d)", c.sidecarURL, resp.StatusCode)
+	}
+
+	if err := handleAPIResponse(resp, nil); err != nil {
+		return fmt.Errorf("failed to validate credentials: %w", err)
+	}
+
+	return nil
+}
+
 func (c *Client) makeRequest(method, endpoint string, body interface{}, apiGateway string) (*http.Response, error) {
 	url := ""
 
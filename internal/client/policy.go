@@ -0,0 +1,49 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Policy types reported by the unified policy listing
+const (
+	PolicyTypeImpersonation             = "impersonation"
+	PolicyTypeAccessManagementOLTP      = "access_management_oltp"
+	PolicyTypeAccessManagementSnowflake = "access_management_snowflake"
+)
+
+// PolicySummary is the type-independent view of a policy returned by the
+// unified policy listing. Rules are not included; read the policy by ID for them.
+type PolicySummary struct {
+	ID            string  `json:"policy_id"`
+	Name          string  `json:"policy_name"`
+	Description   string  `json:"description"`
+	Type          string  `json:"policy_type"`
+	RepoName      string  `json:"repo_name"`      // Impersonation and OLTP policies only
+	ConnectionIds []int64 `json:"connection_ids"` // Snowflake policies only
+	CreatedAt     string  `json:"created_at"`
+	UpdatedAt     string  `json:"updated_at"`
+}
+
+// ListPolicies lists the policies of all types in the organization
+func (c *Client) ListPolicies() ([]PolicySummary, error) {
+	resp, err := c.makeRequest(http.MethodGet, "/unified-policy/management/policy", nil, "external")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policies: %w", err)
+	}
+
+	var response struct {
+		Data struct {
+			Policies []PolicySummary `json:"policies"`
+		} `json:"data"`
+	}
+
+	if err := handleAPIResponse(resp, &response); err != nil {
+		return nil, fmt.Errorf("failed to list policies: %w", err)
+	}
+
+	return response.Data.Policies, nil
+}
@@ -43,6 +43,21 @@ func (c *Client) GetRepo(repoName string) (*Repo, error) {
 	return &repo, nil
 }
 
+// ListRepos lists all repos in the organization
+func (c *Client) ListRepos() ([]Repo, error) {
+	resp, err := c.makeRequest(http.MethodGet, "/repos", nil, "sidecar")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repos: %w", err)
+	}
+
+	var output ListReposOutput
+	if err := handleAPIResponse(resp, &output); err != nil {
+		return nil, fmt.Errorf("failed to list repos: %w", err)
+	}
+
+	return output.Repos, nil
+}
+
 // UpdateRepo updates an existing repo
 func (c *Client) UpdateRepo(repoName string, input UpdateRepoInput) (*Repo, error) {
 	resp, err := c.makeRequest(http.MethodPatch, "/repos/"+url.PathEscape(repoName), input, "sidecar")
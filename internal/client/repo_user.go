@@ -43,6 +43,30 @@ func (c *Client) GetRepoUser(repoName, username string) (*RepoUser, error) {
 	return &repoUser, nil
 }
 
+// ListRepoUsers lists all users of a repo
+func (c *Client) ListRepoUsers(repoName string) ([]RepoUser, error) {
+	resp, err := c.makeRequest(http.MethodGet, fmt.Sprintf("/repos/%s/users", url.PathEscape(repoName)), nil, "sidecar")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repo users: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	var output ListRepoUsersOutput
+	if err := handleAPIResponse(resp, &output); err != nil {
+		return nil, fmt.Errorf("failed to list repo users: %w", err)
+	}
+
+	// A repo without users is not the same as a missing repo
+	if output.RepoUsers == nil {
+		return []RepoUser{}, nil
+	}
+
+	return output.RepoUsers, nil
+}
+
 // UpdateRepoUser updates an existing repo user
 func (c *Client) UpdateRepoUser(repoName, username string, input UpdateRepoUserInput) (*RepoUser, error) {
 	resp, err := c.makeRequest(http.MethodPatch, fmt.Sprintf("/repos/%s/users/%s", url.PathEscape(repoName), url.PathEscape(username)), input, "sidecar")
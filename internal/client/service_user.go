@@ -43,6 +43,30 @@ func (c *Client) GetServiceUser(repoName, username string) (*ServiceUser, error)
 	return &serviceUser, nil
 }
 
+// ListServiceUsers lists all service users of a repo
+func (c *Client) ListServiceUsers(repoName string) ([]ServiceUser, error) {
+	resp, err := c.makeRequest(http.MethodGet, fmt.Sprintf("/repos/%s/serviceusers", url.PathEscape(repoName)), nil, "sidecar")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list service users: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	var output ListServiceUsersOutput
+	if err := handleAPIResponse(resp, &output); err != nil {
+		return nil, fmt.Errorf("failed to list service users: %w", err)
+	}
+
+	// A repo without users is not the same as a missing repo
+	if output.ServiceUsers == nil {
+		return []ServiceUser{}, nil
+	}
+
+	return output.ServiceUsers, nil
+}
+
 // UpdateServiceUser updates an existing service user
 func (c *Client) UpdateServiceUser(repoName, username string, input UpdateServiceUserInput) (*ServiceUser, error) {
 	resp, err := c.makeRequest(http.MethodPatch, fmt.Sprintf("/repos/%s/serviceusers/%s", url.PathEscape(repoName), url.PathEscape(username)), input, "sidecar")
@@ -43,6 +43,21 @@ func (c *Client) GetSidecar(sidecarID string) (*Sidecar, error) {
 	return &sidecar, nil
 }
 
+// ListSidecars lists all sidecars in the organization
+func (c *Client) ListSidecars() ([]Sidecar, error) {
+	resp, err := c.makeRequest(http.MethodGet, "/sidecars", nil, "sidecar")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sidecars: %w", err)
+	}
+
+	var output ListSidecarsOutput
+	if err := handleAPIResponse(resp, &output); err != nil {
+		return nil, fmt.Errorf("failed to list sidecars: %w", err)
+	}
+
+	return output.Sidecars, nil
+}
+
 // UpdateSidecar updates an existing sidecar
 func (c *Client) UpdateSidecar(sidecarID string, input UpdateSidecarInput) (*Sidecar, error) {
 	resp, err := c.makeRequest(http.MethodPatch, "/sidecars/"+url.PathEscape(sidecarID), input, "sidecar")
@@ -0,0 +1,97 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// SidecarCertificate represents a TLS certificate uploaded to a sidecar. The private
+// key is never returned.
+type SidecarCertificate struct {
+	ID               string `json:"certificate_id"`
+	SidecarID        string `json:"sidecar_id"`
+	Name             string `json:"name"`
+	CertificateChain string `json:"certificate_chain"`
+	NotAfter         string `json:"not_after"`
+	CreatedAt        string `json:"created_at"`
+	UpdatedAt        string `json:"updated_at"`
+}
+
+// SidecarCertificateInput is used both to upload and to replace a certificate
+type SidecarCertificateInput struct {
+	Name             string `json:"name"`
+	CertificateChain string `json:"certificate_chain"`
+	PrivateKey       string `json:"private_key"`
+}
+
+// CreateSidecarCertificate uploads a new certificate to a sidecar
+func (c *Client) CreateSidecarCertificate(sidecarID string, input SidecarCertificateInput) (*SidecarCertificate, error) {
+	resp, err := c.makeRequest(http.MethodPost, fmt.Sprintf("/sidecars/%s/certificates", url.PathEscape(sidecarID)), input, "sidecar")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sidecar certificate: %w", err)
+	}
+
+	var certificate SidecarCertificate
+	if err := handleAPIResponse(resp, &certificate); err != nil {
+		return nil, fmt.Errorf("failed to create sidecar certificate: %w", err)
+	}
+
+	return &certificate, nil
+}
+
+// GetSidecarCertificate retrieves a sidecar certificate by ID
+func (c *Client) GetSidecarCertificate(sidecarID, certificateID string) (*SidecarCertificate, error) {
+	resp, err := c.makeRequest(http.MethodGet, fmt.Sprintf("/sidecars/%s/certificates/%s", url.PathEscape(sidecarID), url.PathEscape(certificateID)), nil, "sidecar")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sidecar certificate: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	var certificate SidecarCertificate
+	if err := handleAPIResponse(resp, &certificate); err != nil {
+		return nil, fmt.Errorf("failed to get sidecar certificate: %w", err)
+	}
+
+	return &certificate, nil
+}
+
+// UpdateSidecarCertificate replaces the name, chain and key of a sidecar certificate;
+// listeners referencing it pick up the new certificate without being re-registered
+func (c *Client) UpdateSidecarCertificate(sidecarID, certificateID string, input SidecarCertificateInput) (*SidecarCertificate, error) {
+	resp, err := c.makeRequest(http.MethodPut, fmt.Sprintf("/sidecars/%s/certificates/%s", url.PathEscape(sidecarID), url.PathEscape(certificateID)), input, "sidecar")
+	if err != nil {
+		return nil, fmt.Errorf("failed to update sidecar certificate: %w", err)
+	}
+
+	var certificate SidecarCertificate
+	if err := handleAPIResponse(resp, &certificate); err != nil {
+		return nil, fmt.Errorf("failed to update sidecar certificate: %w", err)
+	}
+
+	return &certificate, nil
+}
+
+// DeleteSidecarCertificate deletes a sidecar certificate
+func (c *Client) DeleteSidecarCertificate(sidecarID, certificateID string) error {
+	resp, err := c.makeRequest(http.MethodDelete, fmt.Sprintf("/sidecars/%s/certificates/%s", url.PathEscape(sidecarID), url.PathEscape(certificateID)), nil, "sidecar")
+	if err != nil {
+		return fmt.Errorf("failed to delete sidecar certificate: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	if err := handleAPIResponse(resp, nil); err != nil {
+		return fmt.Errorf("failed to delete sidecar certificate: %w", err)
+	}
+
+	return nil
+}
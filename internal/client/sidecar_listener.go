@@ -60,6 +60,20 @@ func (c *Client) ListSidecarListeners(sidecarID string) ([]ListenerPort, error)
 	return output.SidecarListeners, nil
 }
 
+// UpdateSidecarListener updates a sidecar listener in place without deregistering the port
+func (c *Client) UpdateSidecarListener(sidecarID string, port int, input UpdateSidecarListenerInput) error {
+	resp, err := c.makeRequest(http.MethodPatch, fmt.Sprintf("/sidecars/%s/ports/%s", url.PathEscape(sidecarID), strconv.Itoa(port)), input, "sidecar")
+	if err != nil {
+		return fmt.Errorf("failed to update sidecar listener: %w", err)
+	}
+
+	if err := handleAPIResponse(resp, nil); err != nil {
+		return fmt.Errorf("failed to update sidecar listener: %w", err)
+	}
+
+	return nil
+}
+
 // DeregisterSidecarListener removes a sidecar listener
 func (c *Client) DeregisterSidecarListener(sidecarID string, port int) error {
 	resp, err := c.makeRequest(http.MethodDelete, fmt.Sprintf("/sidecars/%s/ports/%s", url.PathEscape(sidecarID), strconv.Itoa(port)), nil, "sidecar")
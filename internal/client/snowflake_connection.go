@@ -0,0 +1,158 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// SnowflakeConnection represents a connection ALTR uses to manage a Snowflake account
+type SnowflakeConnection struct {
+	ID        int64                 `json:"connection_id"`
+	Name      string                `json:"connection_name"`
+	Account   string                `json:"account"`
+	Role      string                `json:"role"`
+	Warehouse string                `json:"warehouse"`
+	KeyPair   *SnowflakeKeyPairAuth `json:"key_pair,omitempty"`
+	OAuth     *SnowflakeOAuthAuth   `json:"oauth,omitempty"`
+	CreatedAt string                `json:"created_at"`
+	UpdatedAt string                `json:"updated_at"`
+}
+
+// CredentialProviders holds where a secret is read from; exactly one provider is set
+type CredentialProviders struct {
+	AWSSecretsManager   *AWSSecretsManager   `json:"aws_secrets_manager,omitempty"`
+	AzureKeyVault       *AzureKeyVault       `json:"azure_key_vault,omitempty"`
+	EnvironmentVariable *EnvironmentVariable `json:"environment_variable,omitempty"`
+	SecretFile          *SecretFile          `json:"secret_file,omitempty"`
+}
+
+type SnowflakeKeyPairAuth struct {
+	Username   string               `json:"username"`
+	PrivateKey CredentialProviders  `json:"private_key"`
+	Passphrase *CredentialProviders `json:"passphrase,omitempty"`
+}
+
+type SnowflakeOAuthAuth struct {
+	ClientID      string              `json:"client_id"`
+	ClientSecret  CredentialProviders `json:"client_secret"`
+	TokenEndpoint string              `json:"token_endpoint,omitempty"`
+}
+
+type CreateSnowflakeConnectionInput struct {
+	Name      string                `json:"connection_name"`
+	Account   string                `json:"account"`
+	Role      string                `json:"role"`
+	Warehouse string                `json:"warehouse"`
+	KeyPair   *SnowflakeKeyPairAuth `json:"key_pair,omitempty"`
+	OAuth     *SnowflakeOAuthAuth   `json:"oauth,omitempty"`
+}
+
+// UpdateSnowflakeConnectionInput replaces the connection settings; the authentication
+// method that is not set is removed
+type UpdateSnowflakeConnectionInput struct {
+	Name      string                `json:"connection_name"`
+	Account   string                `json:"account"`
+	Role      string                `json:"role"`
+	Warehouse string                `json:"warehouse"`
+	KeyPair   *SnowflakeKeyPairAuth `json:"key_pair,omitempty"`
+	OAuth     *SnowflakeOAuthAuth   `json:"oauth,omitempty"`
+}
+
+// CreateSnowflakeConnection creates a new Snowflake connection
+func (c *Client) CreateSnowflakeConnection(input CreateSnowflakeConnectionInput) (*SnowflakeConnection, error) {
+	resp, err := c.makeRequest(http.MethodPost, "/snowflake/connections", input, "external")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Snowflake connection: %w", err)
+	}
+
+	var response struct {
+		Data SnowflakeConnection `json:"data"`
+	}
+
+	if err := handleAPIResponse(resp, &response); err != nil {
+		return nil, fmt.Errorf("failed to create Snowflake connection: %w", err)
+	}
+
+	return &response.Data, nil
+}
+
+// GetSnowflakeConnection retrieves a Snowflake connection by ID
+func (c *Client) GetSnowflakeConnection(connectionID int64) (*SnowflakeConnection, error) {
+	resp, err := c.makeRequest(http.MethodGet, fmt.Sprintf("/snowflake/connections/%d", connectionID), nil, "external")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Snowflake connection: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	var response struct {
+		Data SnowflakeConnection `json:"data"`
+	}
+
+	if err := handleAPIResponse(resp, &response); err != nil {
+		return nil, fmt.Errorf("failed to get Snowflake connection: %w", err)
+	}
+
+	return &response.Data, nil
+}
+
+// ListSnowflakeConnections lists all Snowflake connections
+func (c *Client) ListSnowflakeConnections() ([]SnowflakeConnection, error) {
+	resp, err := c.makeRequest(http.MethodGet, "/snowflake/connections", nil, "external")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Snowflake connections: %w", err)
+	}
+
+	var response struct {
+		Data struct {
+			Connections []SnowflakeConnection `json:"connections"`
+		} `json:"data"`
+	}
+
+	if err := handleAPIResponse(resp, &response); err != nil {
+		return nil, fmt.Errorf("failed to list Snowflake connections: %w", err)
+	}
+
+	return response.Data.Connections, nil
+}
+
+// UpdateSnowflakeConnection updates an existing Snowflake connection
+func (c *Client) UpdateSnowflakeConnection(connectionID int64, input UpdateSnowflakeConnectionInput) (*SnowflakeConnection, error) {
+	resp, err := c.makeRequest(http.MethodPut, fmt.Sprintf("/snowflake/connections/%d", connectionID), input, "external")
+	if err != nil {
+		return nil, fmt.Errorf("failed to update Snowflake connection: %w", err)
+	}
+
+	var response struct {
+		Data SnowflakeConnection `json:"data"`
+	}
+
+	if err := handleAPIResponse(resp, &response); err != nil {
+		return nil, fmt.Errorf("failed to update Snowflake connection: %w", err)
+	}
+
+	return &response.Data, nil
+}
+
+// DeleteSnowflakeConnection deletes a Snowflake connection
+func (c *Client) DeleteSnowflakeConnection(connectionID int64) error {
+	resp, err := c.makeRequest(http.MethodDelete, fmt.Sprintf("/snowflake/connections/%d", connectionID), nil, "external")
+	if err != nil {
+		return fmt.Errorf("failed to delete Snowflake connection: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	if err := handleAPIResponse(resp, nil); err != nil {
+		return fmt.Errorf("failed to delete Snowflake connection: %w", err)
+	}
+
+	return nil
+}
@@ -27,6 +27,11 @@ type PublicKey struct {
 	RegisteredAt string `json:"registered_at"`
 }
 
+type ListSidecarsOutput struct {
+	Sidecars     []Sidecar `json:"sidecars"`
+	ContiguousID string    `json:"contiguous_id"`
+}
+
 type CreateSidecarInput struct {
 	Name                   string `json:"name"`
 	Description            string `json:"description"`
@@ -47,29 +52,76 @@ type UpdateSidecarInput struct {
 
 // Repo structures
 type Repo struct {
-	Name             string `json:"name"`
-	Description      string `json:"description"`
-	Hostname         string `json:"hostname"`
-	Port             int    `json:"port"`
-	Type             string `json:"type"`
-	UserCount        int    `json:"user_count"`
-	ServiceUserCount int    `json:"service_user_count"`
-	BindingCount     int    `json:"binding_count"`
-	OrgID            string `json:"org_id"`
-	CreatedAt        string `json:"created_at"`
-	UpdatedAt        string `json:"updated_at"`
+	Name              string                 `json:"name"`
+	Description       string                 `json:"description"`
+	Hostname          string                 `json:"hostname"`
+	Port              int                    `json:"port"`
+	Type              string                 `json:"type"`
+	AccountLocator    string                 `json:"account_locator,omitempty"`
+	Warehouse         string                 `json:"warehouse,omitempty"`
+	HTTPPath          string                 `json:"http_path,omitempty"`
+	ConnectionOptions *RepoConnectionOptions `json:"connection_options,omitempty"`
+	UserCount         int                    `json:"user_count"`
+	ServiceUserCount  int                    `json:"service_user_count"`
+	BindingCount      int                    `json:"binding_count"`
+	OrgID             string                 `json:"org_id"`
+	CreatedAt         string                 `json:"created_at"`
+	UpdatedAt         string                 `json:"updated_at"`
+}
+
+type ListReposOutput struct {
+	Repos        []Repo `json:"repos"`
+	ContiguousID string `json:"contiguous_id"`
 }
 
 type CreateRepoInput struct {
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	Type        string `json:"type"`
-	Hostname    string `json:"hostname"`
-	Port        int    `json:"port"`
+	Name              string                 `json:"name"`
+	Description       string                 `json:"description"`
+	Type              string                 `json:"type"`
+	Hostname          string                 `json:"hostname"`
+	Port              int                    `json:"port"`
+	AccountLocator    string                 `json:"account_locator,omitempty"`
+	Warehouse         string                 `json:"warehouse,omitempty"`
+	HTTPPath          string                 `json:"http_path,omitempty"`
+	ConnectionOptions *RepoConnectionOptions `json:"connection_options,omitempty"`
 }
 
 type UpdateRepoInput struct {
-	Description string `json:"description"`
+	Description    *string `json:"description,omitempty"`
+	Hostname       *string `json:"hostname,omitempty"`
+	Port           *int    `json:"port,omitempty"`
+	AccountLocator *string `json:"account_locator,omitempty"`
+	Warehouse      *string `json:"warehouse,omitempty"`
+	HTTPPath       *string `json:"http_path,omitempty"`
+	// An empty RepoConnectionOptions clears the connection options
+	ConnectionOptions *RepoConnectionOptions `json:"connection_options,omitempty"`
+}
+
+// RepoConnectionOptions holds the settings of the repository type; at most one is set
+type RepoConnectionOptions struct {
+	Oracle   *OracleConnectionOptions   `json:"oracle,omitempty"`
+	Postgres *PostgresConnectionOptions `json:"postgres,omitempty"`
+	MSSQL    *MSSQLConnectionOptions    `json:"mssql,omitempty"`
+	MySQL    *MySQLConnectionOptions    `json:"mysql,omitempty"`
+}
+
+type OracleConnectionOptions struct {
+	ServiceName string `json:"service_name,omitempty"`
+	SID         string `json:"sid,omitempty"`
+}
+
+type PostgresConnectionOptions struct {
+	Database string `json:"database,omitempty"`
+	SSLMode  string `json:"sslmode,omitempty"`
+}
+
+type MSSQLConnectionOptions struct {
+	InstanceName string `json:"instance_name,omitempty"`
+	Encrypt      *bool  `json:"encrypt,omitempty"`
+}
+
+type MySQLConnectionOptions struct {
+	TLSMode string `json:"tls_mode,omitempty"`
 }
 
 type RepoUser struct {
@@ -83,6 +135,11 @@ type RepoUser struct {
 	UpdatedAt           string               `json:"updated_at"`
 }
 
+type ListRepoUsersOutput struct {
+	RepoUsers    []RepoUser `json:"repo_users"`
+	ContiguousID string     `json:"contiguous_id"`
+}
+
 type UpdateRepoUserInput struct {
 	AWSSecretsManager   *AWSSecretsManager   `json:"aws_secrets_manager,omitempty"`
 	AzureKeyVault       *AzureKeyVault       `json:"azure_key_vault,omitempty"`
@@ -110,15 +167,33 @@ type CreateRepoUserInput struct {
 
 // Sidecar Listener structures
 type ListenerPort struct {
-	Port              int    `json:"port"`
-	DatabaseType      string `json:"database_type"`
-	AdvertisedVersion string `json:"advertised_version"`
+	Port              int          `json:"port"`
+	DatabaseType      string       `json:"database_type"`
+	AdvertisedVersion string       `json:"advertised_version"`
+	TLS               *ListenerTLS `json:"tls,omitempty"`
+}
+
+// ListenerTLS holds the client TLS settings of a listener; the sidecar terminates TLS
+// with the referenced certificate
+type ListenerTLS struct {
+	Enabled       bool   `json:"enabled"`
+	Required      bool   `json:"required"`
+	MinVersion    string `json:"min_version,omitempty"`
+	CertificateID string `json:"certificate_id,omitempty"`
 }
 
 type RegisterSidecarListenerInput struct {
-	Port              int    `json:"port"`
-	DatabaseType      string `json:"database_type"`
-	AdvertisedVersion string `json:"advertised_version,omitempty"`
+	Port              int          `json:"port"`
+	DatabaseType      string       `json:"database_type"`
+	AdvertisedVersion string       `json:"advertised_version,omitempty"`
+	TLS               *ListenerTLS `json:"tls,omitempty"`
+}
+
+// UpdateSidecarListenerInput replaces the mutable listener settings; a nil TLS
+// removes the TLS settings of the listener
+type UpdateSidecarListenerInput struct {
+	AdvertisedVersion string       `json:"advertised_version"`
+	TLS               *ListenerTLS `json:"tls"`
 }
 
 type ListSidecarListenersOutput struct {
@@ -161,6 +236,11 @@ type Agent struct {
 	UpdatedAt    string     `json:"updated_at"`
 }
 
+type ListAgentsOutput struct {
+	Agents       []Agent `json:"agents"`
+	ContiguousID string  `json:"contiguous_id"`
+}
+
 type CreateAgentInput struct {
 	Type        string  `json:"type"`
 	Name        string  `json:"name"`
@@ -212,6 +292,11 @@ type AgentTask struct {
 	UpdatedAt     string                 `json:"updated_at"`
 }
 
+type ListAgentTasksOutput struct {
+	Tasks        []AgentTask `json:"tasks"`
+	ContiguousID string      `json:"contiguous_id"`
+}
+
 type CreateAgentTaskInput struct {
 	Name          string                 `json:"name"`
 	Description   string                 `json:"description,omitempty"`
@@ -250,6 +335,11 @@ type ServiceUser struct {
 	UpdatedAt           string               `json:"updated_at"`
 }
 
+type ListServiceUsersOutput struct {
+	ServiceUsers []ServiceUser `json:"service_users"`
+	ContiguousID string        `json:"contiguous_id"`
+}
+
 type CreateServiceUserInput struct {
 	Username            string               `json:"username"`
 	Resource            string               `json:"resource"`
@@ -0,0 +1,83 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package functions
+
+import (
+	"context"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &BindingIDFunction{}
+
+func NewBindingIDFunction() function.Function {
+	return &BindingIDFunction{}
+}
+
+type BindingIDFunction struct{}
+
+func (f *BindingIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "binding_id"
+}
+
+func (f *BindingIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build a repo sidecar binding ID.",
+		Description: "Returns the ID of a repo sidecar binding in the sidecar_id:port:repo_name format used by altr_repo_sidecar_binding and its import.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "sidecar_id",
+				Description: "ID of the sidecar.",
+			},
+			function.Int64Parameter{
+				Name:        "port",
+				Description: "Port of the sidecar listener.",
+			},
+			function.StringParameter{
+				Name:        "repo_name",
+				Description: "Name of the repository.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *BindingIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		sidecarID string
+		port      int64
+		repoName  string
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &sidecarID, &port, &repoName))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validateBindingParts(sidecarID, port, repoName); err != nil {
+		resp.Error = err
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, service.BindingID(sidecarID, port, repoName)))
+}
+
+// validateBindingParts rejects values that would not round-trip through the binding ID format
+func validateBindingParts(sidecarID string, port int64, repoName string) *function.FuncError {
+	if sidecarID == "" || containsSeparator(sidecarID) {
+		return function.NewArgumentFuncError(0, "sidecar_id must be non-empty and must not contain ':'")
+	}
+
+	if port < 1 || port > 65535 {
+		return function.NewArgumentFuncError(1, "port must be between 1 and 65535")
+	}
+
+	if repoName == "" || containsSeparator(repoName) {
+		return function.NewArgumentFuncError(2, "repo_name must be non-empty and must not contain ':'")
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/functions"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBindingIDFunction(t *testing.T) {
+	testCases := map[string]struct {
+		args    []attr.Value
+		want    attr.Value
+		wantErr bool
+	}{
+		"valid": {
+			args: []attr.Value{types.StringValue("0d2a4c8e-1f3b-4a5c-9d7e-6b8f0a1c2d3e"), types.Int64Value(5432), types.StringValue("orders")},
+			want: types.StringValue("0d2a4c8e-1f3b-4a5c-9d7e-6b8f0a1c2d3e:5432:orders"),
+		},
+		"empty-sidecar-id": {
+			args:    []attr.Value{types.StringValue(""), types.Int64Value(5432), types.StringValue("orders")},
+			wantErr: true,
+		},
+		"port-out-of-range": {
+			args:    []attr.Value{types.StringValue("sidecar"), types.Int64Value(70000), types.StringValue("orders")},
+			wantErr: true,
+		},
+		"repo-name-with-separator": {
+			args:    []attr.Value{types.StringValue("sidecar"), types.Int64Value(5432), types.StringValue("a:b")},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := runFunction(functions.NewBindingIDFunction(), types.StringUnknown(), tc.args...)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got result %s", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !got.Equal(tc.want) {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+// runFunction executes a provider-defined function outside of Terraform and returns its result
+func runFunction(f function.Function, result attr.Value, args ...attr.Value) (attr.Value, *function.FuncError) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData(args),
+	}
+	resp := function.RunResponse{
+		Result: function.NewResultData(result),
+	}
+
+	f.Run(context.Background(), req, &resp)
+
+	return resp.Result.Value(), resp.Error
+}
@@ -0,0 +1,82 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package functions
+
+import (
+	"context"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseBindingIDFunction{}
+
+var bindingAttrTypes = map[string]attr.Type{
+	"sidecar_id": types.StringType,
+	"port":       types.Int64Type,
+	"repo_name":  types.StringType,
+}
+
+func NewParseBindingIDFunction() function.Function {
+	return &ParseBindingIDFunction{}
+}
+
+type ParseBindingIDFunction struct{}
+
+func (f *ParseBindingIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_binding_id"
+}
+
+func (f *ParseBindingIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse a repo sidecar binding ID.",
+		Description: "Splits a sidecar_id:port:repo_name binding ID into an object with sidecar_id, port and repo_name attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "Binding ID in the sidecar_id:port:repo_name format.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: bindingAttrTypes,
+		},
+	}
+}
+
+func (f *ParseBindingIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	sidecarID, port, repoName, err := service.ParseBindingID(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+
+		return
+	}
+
+	if funcErr := validateBindingParts(sidecarID, port, repoName); funcErr != nil {
+		resp.Error = function.NewArgumentFuncError(0, "invalid binding ID: "+funcErr.Text)
+
+		return
+	}
+
+	result, diags := types.ObjectValue(bindingAttrTypes, map[string]attr.Value{
+		"sidecar_id": types.StringValue(sidecarID),
+		"port":       types.Int64Value(port),
+		"repo_name":  types.StringValue(repoName),
+	})
+
+	resp.Error = function.ConcatFuncErrors(function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,90 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/functions"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var bindingObjectType = map[string]attr.Type{
+	"sidecar_id": types.StringType,
+	"port":       types.Int64Type,
+	"repo_name":  types.StringType,
+}
+
+func TestParseBindingIDFunction(t *testing.T) {
+	testCases := map[string]struct {
+		id      string
+		want    attr.Value
+		wantErr bool
+	}{
+		"valid": {
+			id: "0d2a4c8e-1f3b-4a5c-9d7e-6b8f0a1c2d3e:5432:orders",
+			want: types.ObjectValueMust(bindingObjectType, map[string]attr.Value{
+				"sidecar_id": types.StringValue("0d2a4c8e-1f3b-4a5c-9d7e-6b8f0a1c2d3e"),
+				"port":       types.Int64Value(5432),
+				"repo_name":  types.StringValue("orders"),
+			}),
+		},
+		"too-few-parts": {
+			id:      "sidecar:5432",
+			wantErr: true,
+		},
+		"too-many-parts": {
+			id:      "sidecar:5432:orders:extra",
+			wantErr: true,
+		},
+		"non-numeric-port": {
+			id:      "sidecar:pg:orders",
+			wantErr: true,
+		},
+		"port-out-of-range": {
+			id:      "sidecar:0:orders",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := runFunction(functions.NewParseBindingIDFunction(), types.ObjectUnknown(bindingObjectType), types.StringValue(tc.id))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got result %s", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !got.Equal(tc.want) {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestParseBindingIDFunction_roundTrip(t *testing.T) {
+	id, err := runFunction(functions.NewBindingIDFunction(), types.StringUnknown(),
+		types.StringValue("sidecar"), types.Int64Value(1521), types.StringValue("hr"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := runFunction(functions.NewParseBindingIDFunction(), types.ObjectUnknown(bindingObjectType), id)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	attrs := got.(types.Object).Attributes()
+	if !attrs["port"].Equal(types.Int64Value(1521)) || !attrs["repo_name"].Equal(types.StringValue("hr")) {
+		t.Errorf("round trip of %s produced %s", id, got)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package functions
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &SnowflakeFQNFunction{}
+
+// snowflakeUnquotedIdentifierRegex matches identifiers Snowflake accepts without double quotes
+var snowflakeUnquotedIdentifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*$`)
+
+func NewSnowflakeFQNFunction() function.Function {
+	return &SnowflakeFQNFunction{}
+}
+
+type SnowflakeFQNFunction struct{}
+
+func (f *SnowflakeFQNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "snowflake_fqn"
+}
+
+func (f *SnowflakeFQNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a fully qualified Snowflake object name.",
+		Description: "Returns database.schema.table. Identifiers that Snowflake would not accept unquoted " +
+			"(spaces, dashes, leading digits, etc.) are wrapped in double quotes, with embedded quotes doubled.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "database",
+				Description: "Database name.",
+			},
+			function.StringParameter{
+				Name:        "schema",
+				Description: "Schema name.",
+			},
+			function.StringParameter{
+				Name:        "table",
+				Description: "Table or view name.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SnowflakeFQNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var database, schema, table string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &database, &schema, &table))
+	if resp.Error != nil {
+		return
+	}
+
+	parts := []string{database, schema, table}
+	for i, part := range parts {
+		if part == "" {
+			resp.Error = function.NewArgumentFuncError(int64(i), "identifier must not be empty")
+
+			return
+		}
+
+		if len(part) > 255 {
+			resp.Error = function.NewArgumentFuncError(int64(i), "identifier must be at most 255 characters")
+
+			return
+		}
+
+		parts[i] = quoteSnowflakeIdentifier(part)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, strings.Join(parts, ".")))
+}
+
+func quoteSnowflakeIdentifier(identifier string) string {
+	if snowflakeUnquotedIdentifierRegex.MatchString(identifier) {
+		return identifier
+	}
+
+	return `"` + strings.ReplaceAll(identifier, `"`, `""`) + `"`
+}
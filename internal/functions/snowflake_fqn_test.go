@@ -0,0 +1,67 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package functions_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/functions"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSnowflakeFQNFunction(t *testing.T) {
+	testCases := map[string]struct {
+		database, schema, table string
+		want                    string
+		wantErr                 bool
+	}{
+		"unquoted": {
+			database: "MY_DB", schema: "PUBLIC", table: "ORDERS",
+			want: "MY_DB.PUBLIC.ORDERS",
+		},
+		"lowercase-and-dollar": {
+			database: "analytics", schema: "raw", table: "events$2024",
+			want: "analytics.raw.events$2024",
+		},
+		"needs-quoting": {
+			database: "my-db", schema: "1st schema", table: "ORDERS",
+			want: `"my-db"."1st schema".ORDERS`,
+		},
+		"embedded-quote": {
+			database: "DB", schema: "PUBLIC", table: `say "hi"`,
+			want: `DB.PUBLIC."say ""hi"""`,
+		},
+		"empty-table": {
+			database: "DB", schema: "PUBLIC",
+			wantErr: true,
+		},
+		"too-long": {
+			database: strings.Repeat("A", 256), schema: "PUBLIC", table: "T",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := runFunction(functions.NewSnowflakeFQNFunction(), types.StringUnknown(),
+				types.StringValue(tc.database), types.StringValue(tc.schema), types.StringValue(tc.table))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got result %s", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !got.Equal(types.StringValue(tc.want)) {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &TaskIDFunction{}
+
+func NewTaskIDFunction() function.Function {
+	return &TaskIDFunction{}
+}
+
+type TaskIDFunction struct{}
+
+func (f *TaskIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "task_id"
+}
+
+func (f *TaskIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build an agent task import ID.",
+		Description: "Returns the ID of an agent task in the agent_id:task_id format expected when importing altr_agent_task.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "agent_id",
+				Description: "UUID of the agent.",
+			},
+			function.StringParameter{
+				Name:        "task_id",
+				Description: "UUID of the task.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TaskIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var agentID, taskID string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &agentID, &taskID))
+	if resp.Error != nil {
+		return
+	}
+
+	if agentID == "" || containsSeparator(agentID) {
+		resp.Error = function.NewArgumentFuncError(0, "agent_id must be non-empty and must not contain ':'")
+
+		return
+	}
+
+	if taskID == "" {
+		resp.Error = function.NewArgumentFuncError(1, "task_id must be non-empty")
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, service.TaskID(agentID, taskID)))
+}
+
+func containsSeparator(value string) bool {
+	return strings.Contains(value, ":")
+}
@@ -0,0 +1,61 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/functions"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTaskIDFunction(t *testing.T) {
+	testCases := map[string]struct {
+		agentID string
+		taskID  string
+		want    attr.Value
+		wantErr bool
+	}{
+		"valid": {
+			agentID: "0d2a4c8e-1f3b-4a5c-9d7e-6b8f0a1c2d3e",
+			taskID:  "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+			want:    types.StringValue("0d2a4c8e-1f3b-4a5c-9d7e-6b8f0a1c2d3e:7c9e6679-7425-40de-944b-e07fc1f90ae7"),
+		},
+		"empty-agent-id": {
+			taskID:  "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+			wantErr: true,
+		},
+		"agent-id-with-separator": {
+			agentID: "a:b",
+			taskID:  "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+			wantErr: true,
+		},
+		"empty-task-id": {
+			agentID: "0d2a4c8e-1f3b-4a5c-9d7e-6b8f0a1c2d3e",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := runFunction(functions.NewTaskIDFunction(), types.StringUnknown(), types.StringValue(tc.agentID), types.StringValue(tc.taskID))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got result %s", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !got.Equal(tc.want) {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
@@ -6,20 +6,31 @@ package provider
 import (
 	"context"
 	"os"
+	"strconv"
 
 	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/altrsoftware/terraform-provider-altr/internal/functions"
 	"github.com/altrsoftware/terraform-provider-altr/internal/service/agent"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service/connection"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service/identity"
 	"github.com/altrsoftware/terraform-provider-altr/internal/service/policy"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service/registration"
 	"github.com/altrsoftware/terraform-provider-altr/internal/service/repo"
 	"github.com/altrsoftware/terraform-provider-altr/internal/service/sidecar"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var _ provider.Provider = &SidecarProvider{}
+var (
+	_ provider.Provider                       = &SidecarProvider{}
+	_ provider.ProviderWithFunctions          = &SidecarProvider{}
+	_ provider.ProviderWithEphemeralResources = &SidecarProvider{}
+)
 
 type SidecarProvider struct {
 	version string
@@ -30,6 +41,8 @@ type SidecarProviderModel struct {
 	ApiKey  types.String `tfsdk:"api_key"`
 	Secret  types.String `tfsdk:"secret"`
 	BaseURL types.String `tfsdk:"base_url"`
+
+	ValidateCredentials types.Bool `tfsdk:"validate_credentials"`
 }
 
 func (p *SidecarProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -59,6 +72,10 @@ func (p *SidecarProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				Description: "ALTR base URL",
 				Optional:    true,
 			},
+			"validate_credentials": schema.BoolAttribute{
+				Description: "Make an authenticated API call while configuring the provider so invalid credentials fail fast. Defaults to false.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -93,6 +110,21 @@ func (p *SidecarProvider) Configure(ctx context.Context, req provider.ConfigureR
 		baseURL = config.BaseURL.ValueString()
 	}
 
+	validateCredentials := false
+	if !config.ValidateCredentials.IsNull() {
+		validateCredentials = config.ValidateCredentials.ValueBool()
+	} else if v := os.Getenv("ALTR_VALIDATE_CREDENTIALS"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid ALTR_VALIDATE_CREDENTIALS Environment Variable",
+				"ALTR_VALIDATE_CREDENTIALS environment variable must be a boolean: "+err.Error(),
+			)
+		}
+
+		validateCredentials = parsed
+	}
+
 	if orgID == "" {
 		resp.Diagnostics.AddError(
 			"Missing Organization ID",
@@ -129,8 +161,20 @@ func (p *SidecarProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	if validateCredentials {
+		if err := client.ValidateCredentials(); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid ALTR Credentials",
+				"The provider could not authenticate with the ALTR API using the configured org_id, api_key and secret: "+err.Error(),
+			)
+
+			return
+		}
+	}
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *SidecarProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -139,29 +183,61 @@ func (p *SidecarProvider) Resources(ctx context.Context) []func() resource.Resou
 		repo.NewRepoResource,
 		repo.NewRepoUserResource,
 		sidecar.NewSidecarListenerResource,
+		sidecar.NewSidecarListenersResource,
+		sidecar.NewSidecarCertificateResource,
 		repo.NewRepoSidecarBindingResource,
+		repo.NewRepoSidecarBindingsResource,
 		policy.NewAccessManagementOltpPolicyDataResource,
 		policy.NewAccessManagementSnowflakePolicyDataResource,
 		policy.NewImpersonationPolicyResource,
 		agent.NewAgentResource,
 		agent.NewAgentTaskResource,
+		sidecar.NewSidecarKeyRotationResource,
+		agent.NewAgentKeyRotationResource,
 		repo.NewServiceUserResource,
+		connection.NewSnowflakeConnectionResource,
 	}
 }
 
 func (p *SidecarProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		sidecar.NewSidecarDataSource,
+		sidecar.NewSidecarsDataSource,
 		sidecar.NewSidecarListenerDataSource,
+		sidecar.NewSidecarListenersDataSource,
 		repo.NewRepoDataSource,
+		repo.NewReposDataSource,
 		repo.NewRepoUserDataSource,
+		repo.NewRepoUsersDataSource,
 		repo.NewRepoSidecarBindingDataSource,
+		repo.NewRepoSidecarBindingsDataSource,
 		repo.NewServiceUserDataSource,
+		repo.NewServiceUsersDataSource,
 		policy.NewAccessManagementOLTPPolicyDataSource,
 		policy.NewAccessManagementSnowflakePolicyDataSource,
 		policy.NewImpersonationPolicyDataSource,
+		policy.NewPoliciesDataSource,
+		connection.NewSnowflakeConnectionDataSource,
 		agent.NewAgentDataSource,
+		agent.NewAgentsDataSource,
 		agent.NewAgentTaskDataSource,
+		agent.NewAgentTasksDataSource,
+		identity.NewCallerIdentityDataSource,
+	}
+}
+
+func (p *SidecarProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		registration.NewRegistrationKeypairEphemeralResource,
+	}
+}
+
+func (p *SidecarProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewBindingIDFunction,
+		functions.NewParseBindingIDFunction,
+		functions.NewTaskIDFunction,
+		functions.NewSnowflakeFQNFunction,
 	}
 }
 
@@ -9,6 +9,8 @@ import (
 	"fmt"
 
 	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/altrsoftware/terraform-provider-altr/internal/validation"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -33,16 +35,21 @@ type AgentResource struct {
 }
 
 type AgentResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	Type         types.String `tfsdk:"type"`
-	Name         types.String `tfsdk:"name"`
-	Description  types.String `tfsdk:"description"`
-	PublicKey1   types.String `tfsdk:"public_key_1"`
-	PublicKey2   types.String `tfsdk:"public_key_2"`
-	TaskCount    types.Int64  `tfsdk:"task_count"`
-	DataPlaneURL types.String `tfsdk:"data_plane_url"`
-	CreatedAt    types.String `tfsdk:"created_at"`
-	UpdatedAt    types.String `tfsdk:"updated_at"`
+	ID                    types.String           `tfsdk:"id"`
+	Type                  types.String           `tfsdk:"type"`
+	Name                  types.String           `tfsdk:"name"`
+	Description           types.String           `tfsdk:"description"`
+	PublicKey1            service.PublicKeyValue `tfsdk:"public_key_1"`
+	PublicKey2            service.PublicKeyValue `tfsdk:"public_key_2"`
+	PublicKey1WO          types.String           `tfsdk:"public_key_1_wo"`
+	PublicKey2WO          types.String           `tfsdk:"public_key_2_wo"`
+	PublicKeyWOVersion    types.Int64            `tfsdk:"public_key_wo_version"`
+	PublicKey1Fingerprint types.String           `tfsdk:"public_key_1_fingerprint"`
+	PublicKey2Fingerprint types.String           `tfsdk:"public_key_2_fingerprint"`
+	TaskCount             types.Int64            `tfsdk:"task_count"`
+	DataPlaneURL          types.String           `tfsdk:"data_plane_url"`
+	CreatedAt             types.String           `tfsdk:"created_at"`
+	UpdatedAt             types.String           `tfsdk:"updated_at"`
 }
 
 func (r *AgentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -83,14 +90,58 @@ func (r *AgentResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:    true,
 			},
 			"public_key_1": schema.StringAttribute{
-				Description: "PEM-encoded RSA public key used by the agent for authentication. At least one public key is required at creation.",
+				Description: "PEM-encoded PKIX RSA public key of at least 2048 bits used by the agent for authentication. At least one public key is required at creation. Differences in whitespace or line wrapping are ignored.",
+				CustomType:  service.PublicKeyType{},
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					validation.RSAPublicKey(),
+				},
 			},
 			"public_key_2": schema.StringAttribute{
-				Description: "Optional second PEM-encoded RSA public key for key rotation.",
+				Description: "Optional second PEM-encoded PKIX RSA public key of at least 2048 bits for key rotation. Differences in whitespace or line wrapping are ignored.",
+				CustomType:  service.PublicKeyType{},
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					validation.RSAPublicKey(),
+				},
+			},
+			"public_key_1_wo": schema.StringAttribute{
+				Description: "Write-only alternative to public_key_1 that accepts ephemeral values, such as altr_registration_keypair.public_key_pem. Only sent when the agent is created or public_key_wo_version changes. Requires Terraform 1.11 or later.",
+				Optional:    true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("public_key_1")),
+					validation.RSAPublicKey(),
+				},
+			},
+			"public_key_2_wo": schema.StringAttribute{
+				Description: "Write-only alternative to public_key_2 that accepts ephemeral values. Only sent when the agent is created or public_key_wo_version changes. Requires Terraform 1.11 or later.",
+				Optional:    true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("public_key_2")),
+					validation.RSAPublicKey(),
+				},
+			},
+			"public_key_wo_version": schema.Int64Attribute{
+				Description: "Version of the write-only public keys. Change this value to send new public_key_1_wo/public_key_2_wo values to ALTR.",
+				Optional:    true,
+			},
+			"public_key_1_fingerprint": schema.StringAttribute{
+				Description: "SHA-256 fingerprint of public_key_1, as the lowercase hex digest of the DER-encoded key (openssl pkey -pubin -outform DER | sha256sum).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					service.PublicKeyFingerprintFrom("public_key_1"),
+				},
+			},
+			"public_key_2_fingerprint": schema.StringAttribute{
+				Description: "SHA-256 fingerprint of public_key_2, as the lowercase hex digest of the DER-encoded key (openssl pkey -pubin -outform DER | sha256sum).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					service.PublicKeyFingerprintFrom("public_key_2"),
+				},
 			},
 			"task_count": schema.Int64Attribute{
 				Description: "Number of tasks currently assigned to this agent.",
@@ -137,15 +188,16 @@ func (r *AgentResource) Configure(ctx context.Context, req resource.ConfigureReq
 }
 
 func (r *AgentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan AgentResourceModel
+	var plan, config AgentResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if err := r.validatePublicKeys(&plan); err != nil {
+	if err := r.validatePublicKeys(&config); err != nil {
 		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
 
 		return
@@ -165,6 +217,14 @@ func (r *AgentResource) Create(ctx context.Context, req resource.CreateRequest,
 		input.PublicKey2 = plan.PublicKey2.ValueStringPointer()
 	}
 
+	if hasValue(config.PublicKey1WO) {
+		input.PublicKey1 = config.PublicKey1WO.ValueStringPointer()
+	}
+
+	if hasValue(config.PublicKey2WO) {
+		input.PublicKey2 = config.PublicKey2WO.ValueStringPointer()
+	}
+
 	a, err := r.client.CreateAgent(input)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -212,18 +272,20 @@ func (r *AgentResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 func (r *AgentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var (
-		plan  AgentResourceModel
-		state AgentResourceModel
+		plan   AgentResourceModel
+		state  AgentResourceModel
+		config AgentResourceModel
 	)
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if err := r.validatePublicKeys(&plan); err != nil {
+	if err := r.validatePublicKeys(&config); err != nil {
 		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
 
 		return
@@ -251,6 +313,17 @@ func (r *AgentResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
+	// Write-only keys are never stored, so they are only re-sent when their version changes
+	if !plan.PublicKeyWOVersion.Equal(state.PublicKeyWOVersion) {
+		if hasValue(config.PublicKey1WO) {
+			input.PublicKey1 = config.PublicKey1WO.ValueStringPointer()
+		}
+
+		if hasValue(config.PublicKey2WO) {
+			input.PublicKey2 = config.PublicKey2WO.ValueStringPointer()
+		}
+	}
+
 	a, err := r.client.UpdateAgent(state.ID.ValueString(), input)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -290,9 +363,10 @@ func (r *AgentResource) ImportState(ctx context.Context, req resource.ImportStat
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func (r *AgentResource) validatePublicKeys(model *AgentResourceModel) error {
-	has1 := !model.PublicKey1.IsNull() && !model.PublicKey1.IsUnknown() && model.PublicKey1.ValueString() != ""
-	has2 := !model.PublicKey2.IsNull() && !model.PublicKey2.IsUnknown() && model.PublicKey2.ValueString() != ""
+// validatePublicKeys checks the configuration, which is the only place write-only keys are visible
+func (r *AgentResource) validatePublicKeys(config *AgentResourceModel) error {
+	has1 := hasValue(config.PublicKey1.StringValue) || hasValue(config.PublicKey1WO)
+	has2 := hasValue(config.PublicKey2.StringValue) || hasValue(config.PublicKey2WO)
 
 	if !has1 && !has2 {
 		return errors.New("at least one of 'public_key_1' or 'public_key_2' must be specified")
@@ -301,6 +375,10 @@ func (r *AgentResource) validatePublicKeys(model *AgentResourceModel) error {
 	return nil
 }
 
+func hasValue(value types.String) bool {
+	return !value.IsNull() && !value.IsUnknown() && value.ValueString() != ""
+}
+
 func (r *AgentResource) mapAgentToModel(a *client.Agent, model *AgentResourceModel) {
 	model.ID = types.StringValue(a.ID)
 	model.Type = types.StringValue(a.Type)
@@ -312,14 +390,17 @@ func (r *AgentResource) mapAgentToModel(a *client.Agent, model *AgentResourceMod
 	model.UpdatedAt = types.StringValue(a.UpdatedAt)
 
 	if a.PublicKey1 != nil && a.PublicKey1.RSAKey != "" {
-		model.PublicKey1 = types.StringValue(a.PublicKey1.RSAKey)
+		model.PublicKey1 = service.NewPublicKeyValue(a.PublicKey1.RSAKey)
 	} else {
-		model.PublicKey1 = types.StringNull()
+		model.PublicKey1 = service.NewPublicKeyNull()
 	}
 
 	if a.PublicKey2 != nil && a.PublicKey2.RSAKey != "" {
-		model.PublicKey2 = types.StringValue(a.PublicKey2.RSAKey)
+		model.PublicKey2 = service.NewPublicKeyValue(a.PublicKey2.RSAKey)
 	} else {
-		model.PublicKey2 = types.StringNull()
+		model.PublicKey2 = service.NewPublicKeyNull()
 	}
+
+	model.PublicKey1Fingerprint = service.PublicKeyFingerprintValue(model.PublicKey1)
+	model.PublicKey2Fingerprint = service.PublicKeyFingerprintValue(model.PublicKey2)
 }
@@ -7,17 +7,23 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/altrsoftware/terraform-provider-altr/internal/client"
 	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var _ datasource.DataSource = &AgentDataSource{}
+var (
+	_ datasource.DataSource                     = &AgentDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &AgentDataSource{}
+)
 
 func NewAgentDataSource() datasource.DataSource {
 	return &AgentDataSource{}
@@ -49,8 +55,9 @@ func (d *AgentDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 		Description: "Data source for retrieving information about an ALTR agent.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Agent UUID.",
-				Required:    true,
+				Description: "Agent UUID. Exactly one of id or name must be specified.",
+				Optional:    true,
+				Computed:    true,
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(
 						regexp.MustCompile(service.UUIDv4Regex),
@@ -63,8 +70,12 @@ func (d *AgentDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				Computed:    true,
 			},
 			"name": schema.StringAttribute{
-				Description: "Human-readable name for the agent.",
+				Description: "Human-readable name for the agent. Exactly one of id or name must be specified; the name must match exactly one agent.",
+				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
 			"description": schema.StringAttribute{
 				Description: "Description of the agent.",
@@ -98,6 +109,15 @@ func (d *AgentDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 	}
 }
 
+func (d *AgentDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d *AgentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -125,23 +145,65 @@ func (d *AgentDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	a, err := d.client.GetAgent(config.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading agent",
-			"Could not read agent "+config.ID.ValueString()+": "+err.Error(),
-		)
-
-		return
-	}
-
-	if a == nil {
-		resp.Diagnostics.AddError(
-			"Agent not found",
-			"Agent with ID '"+config.ID.ValueString()+"' does not exist.",
-		)
-
-		return
+	var a *client.Agent
+
+	if !config.Name.IsNull() {
+		agents, err := d.client.ListAgents()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading agent",
+				"Could not list agents, unexpected error: "+err.Error(),
+			)
+
+			return
+		}
+
+		var matches []string
+		for i := range agents {
+			if agents[i].Name == config.Name.ValueString() {
+				a = &agents[i]
+				matches = append(matches, agents[i].ID)
+			}
+		}
+
+		if len(matches) == 0 {
+			resp.Diagnostics.AddError(
+				"Agent not found",
+				"No agent named '"+config.Name.ValueString()+"' exists.",
+			)
+
+			return
+		}
+
+		if len(matches) > 1 {
+			resp.Diagnostics.AddError(
+				"Multiple agents found",
+				fmt.Sprintf("%d agents are named '%s' (IDs: %s). Look the agent up by id instead.", len(matches), config.Name.ValueString(), strings.Join(matches, ", ")),
+			)
+
+			return
+		}
+	} else {
+		var err error
+
+		a, err = d.client.GetAgent(config.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading agent",
+				"Could not read agent "+config.ID.ValueString()+": "+err.Error(),
+			)
+
+			return
+		}
+
+		if a == nil {
+			resp.Diagnostics.AddError(
+				"Agent not found",
+				"Agent with ID '"+config.ID.ValueString()+"' does not exist.",
+			)
+
+			return
+		}
 	}
 
 	d.mapAgentToModel(a, &config)
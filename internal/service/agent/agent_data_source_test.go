@@ -0,0 +1,65 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAgentDataSource_byName(t *testing.T) {
+	resourceName := "altr_agent.test"
+	name := acctest.RandomWithPrefixUnderscoreMaxLength("agent_test", 64)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAgentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentResourceConfig_basic(name) + `
+data "altr_agent" "by_id" {
+  id = altr_agent.test.id
+}
+
+data "altr_agent" "by_name" {
+  name = altr_agent.test.name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.altr_agent.by_id", "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair("data.altr_agent.by_name", "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair("data.altr_agent.by_name", "type", resourceName, "type"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAgentDataSource_lookupValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "altr_agent" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Exactly one of these attributes must be configured: \[id,name\]`),
+			},
+			{
+				Config: `
+data "altr_agent" "test" {
+  id   = "682bfaa1-77e1-40aa-897f-1a0469f4ac64"
+  name = "example"
+}
+`,
+				ExpectError: regexp.MustCompile(`Exactly one of these attributes must be configured: \[id,name\]`),
+			},
+		},
+	})
+}
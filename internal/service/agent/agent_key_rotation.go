@@ -0,0 +1,42 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewAgentKeyRotationResource() resource.Resource {
+	return service.NewKeyRotationResource("agent", "an agent", func(c *client.Client) service.PublicKeySlotClient { return agentKeySlots{c} })
+}
+
+// agentKeySlots exposes the key slots of an agent to the shared rotation logic
+type agentKeySlots struct {
+	client *client.Client
+}
+
+func (k agentKeySlots) GetPublicKeySlots(id string) (*service.PublicKeySlots, error) {
+	agent, err := k.client.GetAgent(id)
+	if err != nil || agent == nil {
+		return nil, err
+	}
+
+	return &service.PublicKeySlots{ID: agent.ID, Key1: agent.PublicKey1, Key2: agent.PublicKey2}, nil
+}
+
+func (k agentKeySlots) UpdatePublicKeySlot(id string, slot int64, value string) (*service.PublicKeySlots, error) {
+	input := client.UpdateAgentInput{PublicKey1: &value}
+	if slot == 2 {
+		input = client.UpdateAgentInput{PublicKey2: &value}
+	}
+
+	agent, err := k.client.UpdateAgent(id, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &service.PublicKeySlots{ID: agent.ID, Key1: agent.PublicKey1, Key2: agent.PublicKey2}, nil
+}
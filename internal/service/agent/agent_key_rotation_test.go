@@ -0,0 +1,73 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAgentPublicKey2Fingerprint is the SHA-256 digest of the DER encoding of testAgentPublicKey2
+const testAgentPublicKey2Fingerprint = "07e452a095e39dff82ea85eab94c3b393eee648519e27319389f5d23db89ed35"
+
+func TestAccAgentKeyRotationResource_basic(t *testing.T) {
+	resourceName := "altr_agent_key_rotation.test"
+	name := acctest.RandomWithPrefixUnderscoreMaxLength("agent_test", 64)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAgentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentKeyRotationResourceConfig(name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "agent_id", "altr_agent.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "active_slot", "2"),
+					resource.TestCheckResourceAttr(resourceName, "previous_slot", "1"),
+					resource.TestCheckResourceAttr(resourceName, "public_key_fingerprint", testAgentPublicKey2Fingerprint),
+				),
+			},
+			{
+				Config: testAccAgentKeyRotationResourceConfig(name, testAgentPublicKey2Fingerprint),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "active_slot", "2"),
+					resource.TestCheckNoResourceAttr(resourceName, "previous_slot"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAgentKeyRotationResourceConfig(name, confirmedFingerprint string) string {
+	confirmed := ""
+	if confirmedFingerprint != "" {
+		confirmed = fmt.Sprintf("confirmed_fingerprint = %q", confirmedFingerprint)
+	}
+
+	return fmt.Sprintf(`
+resource "altr_agent" "test" {
+  type         = "CLASSIFIER"
+  name         = %[1]q
+  public_key_1 = <<-EOT
+%[2]s
+EOT
+
+  lifecycle {
+    ignore_changes = [public_key_1, public_key_2]
+  }
+}
+
+resource "altr_agent_key_rotation" "test" {
+  agent_id   = altr_agent.test.id
+  public_key = <<-EOT
+%[3]s
+EOT
+  %[4]s
+}
+`, name, testAgentPublicKey1, testAgentPublicKey2, confirmed)
+}
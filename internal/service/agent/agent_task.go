@@ -6,9 +6,10 @@ package agent
 import (
 	"context"
 	"fmt"
-	"strings"
+	"slices"
 
 	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -25,6 +26,7 @@ import (
 var (
 	_ resource.Resource                = &AgentTaskResource{}
 	_ resource.ResourceWithImportState = &AgentTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &AgentTaskResource{}
 )
 
 func NewAgentTaskResource() resource.Resource {
@@ -124,7 +126,7 @@ func (r *AgentTaskResource) Schema(ctx context.Context, req resource.SchemaReque
 				Required:    true,
 				Attributes: map[string]schema.Attribute{
 					"classification_type": schema.Int64Attribute{
-						Description: "Classification engine to use: 1 (GOOGLE_DLP), 2 (SNOWFLAKE_NATIVE), 3 (SNOWFLAKE_OBJECT_TAG_IMPORT), 4 (SNOWFLAKE_NATIVE_AND_TAG_IMPORT), or 5 (ALTR_NATIVE).",
+						Description: "Classification engine to use: 1 (GOOGLE_DLP), 2 (SNOWFLAKE_NATIVE), 3 (SNOWFLAKE_OBJECT_TAG_IMPORT), 4 (SNOWFLAKE_NATIVE_AND_TAG_IMPORT), or 5 (ALTR_NATIVE). Types 2 to 4 require a Snowflake repository.",
 						Required:    true,
 						Validators: []validator.Int64{
 							int64validator.OneOf(1, 2, 3, 4, 5),
@@ -386,8 +388,8 @@ func (r *AgentTaskResource) Delete(ctx context.Context, req resource.DeleteReque
 
 func (r *AgentTaskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Format: agent_id:task_id
-	parts := strings.SplitN(req.ID, ":", 2)
-	if len(parts) != 2 {
+	agentID, taskID, err := service.ParseTaskID(req.ID)
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
 			"Expected import ID in format: agent_id:task_id",
@@ -396,8 +398,8 @@ func (r *AgentTaskResource) ImportState(ctx context.Context, req resource.Import
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("agent_id"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("agent_id"), agentID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), taskID)...)
 }
 
 // collectionNameClassificationType is the only classification_type
@@ -419,6 +421,82 @@ func (r *AgentTaskResource) validateConfiguration(model *AgentTaskResourceModel)
 	return nil
 }
 
+// snowflakeClassificationTypes are the classification_types (SNOWFLAKE_NATIVE,
+// SNOWFLAKE_OBJECT_TAG_IMPORT and SNOWFLAKE_NATIVE_AND_TAG_IMPORT) that only work
+// against Snowflake repositories.
+var snowflakeClassificationTypes = []int64{2, 3, 4}
+
+// classificationType returns the configured classification_type, or a null value when the
+// configuration is not known yet
+func classificationType(model *AgentTaskResourceModel) types.Int64 {
+	if ct, ok := model.Configuration.Attributes()["classification_type"].(types.Int64); ok {
+		return ct
+	}
+
+	return types.Int64Null()
+}
+
+// ModifyPlan checks that Snowflake-only classification types are only used with a Snowflake
+// repository, so a mismatch is reported before anything is changed
+func (r *AgentTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan AgentTaskResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ct := classificationType(&plan)
+
+	// Values from resources that are not created yet cannot be looked up
+	if plan.RepoName.IsUnknown() || ct.IsNull() || ct.IsUnknown() || !slices.Contains(snowflakeClassificationTypes, ct.ValueInt64()) {
+		return
+	}
+
+	// Only check when the repository or classification type changes
+	if !req.State.Raw.IsNull() {
+		var state AgentTaskResourceModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if state.RepoName.Equal(plan.RepoName) && classificationType(&state).Equal(ct) {
+			return
+		}
+	}
+
+	repo, err := r.client.GetRepo(plan.RepoName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading repository",
+			"Could not read repository "+plan.RepoName.ValueString()+": "+err.Error(),
+		)
+
+		return
+	}
+
+	// A repository that does not exist yet may be created in the same apply
+	if repo == nil || repo.Type == service.DatabaseTypeSnowflake {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("configuration").AtName("classification_type"),
+		"Invalid Configuration",
+		fmt.Sprintf("'classification_type' %d requires a %s repository, but repository '%s' is of type %s.",
+			ct.ValueInt64(), service.DatabaseTypeSnowflake, repo.Name, repo.Type),
+	)
+}
+
 func (r *AgentTaskResource) configFromModel(obj basetypes.ObjectValue) client.AgentTaskConfiguration {
 	attrs := obj.Attributes()
 
@@ -106,72 +106,8 @@ func (d *AgentTaskDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				Description: "Username of the service user the agent authenticates as when connecting to the repository.",
 				Computed:    true,
 			},
-			"configuration": schema.SingleNestedAttribute{
-				Description: "CLASSIFIER task configuration.",
-				Computed:    true,
-				Attributes: map[string]schema.Attribute{
-					"classification_type": schema.Int64Attribute{
-						Description: "Classification engine identifier.",
-						Computed:    true,
-					},
-					"sample_strategy": schema.StringAttribute{
-						Description: "Sampling strategy used when collecting data for classification.",
-						Computed:    true,
-					},
-					"collection_name": schema.StringAttribute{
-						Description: "Name of the classifier collection to use.",
-						Computed:    true,
-					},
-					"ssl_config": schema.SingleNestedAttribute{
-						Description: "SSL/TLS configuration used when connecting to the repository.",
-						Computed:    true,
-						Attributes: map[string]schema.Attribute{
-							"enabled": schema.BoolAttribute{
-								Description: "Whether SSL/TLS is enabled for the connection.",
-								Computed:    true,
-							},
-							"hostname_in_certificate": schema.StringAttribute{
-								Description: "Expected hostname in the server certificate.",
-								Computed:    true,
-							},
-							"trust_server_certificate": schema.BoolAttribute{
-								Description: "Whether to trust the server certificate without validation.",
-								Computed:    true,
-							},
-							"trust_store_password_arn": schema.StringAttribute{
-								Description: "ARN of the secret holding the trust store password.",
-								Computed:    true,
-							},
-							"trust_store_path": schema.StringAttribute{
-								Description: "Path to the trust store used to validate the server certificate.",
-								Computed:    true,
-							},
-						},
-					},
-				},
-			},
-			"schedule": schema.SingleNestedAttribute{
-				Description: "Schedule controlling when the task runs.",
-				Computed:    true,
-				Attributes: map[string]schema.Attribute{
-					"type": schema.StringAttribute{
-						Description: "Schedule type (e.g. CRON).",
-						Computed:    true,
-					},
-					"value": schema.StringAttribute{
-						Description: "Cron expression (5 fields: minute hour dom month dow).",
-						Computed:    true,
-					},
-					"max_duration": schema.StringAttribute{
-						Description: "ISO 8601 duration capping how long a single run may take.",
-						Computed:    true,
-					},
-					"timezone": schema.StringAttribute{
-						Description: "IANA timezone name the cron expression is evaluated in.",
-						Computed:    true,
-					},
-				},
-			},
+			"configuration": agentTaskConfigurationDataSourceAttribute(),
+			"schedule":      agentTaskScheduleDataSourceAttribute(),
 			"created_at": schema.StringAttribute{
 				Description: "Creation timestamp.",
 				Computed:    true,
@@ -245,33 +181,114 @@ func (d *AgentTaskDataSource) mapTaskToModel(task *client.AgentTask, model *Agen
 	model.CreatedAt = types.StringValue(task.CreatedAt)
 	model.UpdatedAt = types.StringValue(task.UpdatedAt)
 
+	model.Configuration = newAgentTaskConfigurationDataSourceModel(task.Configuration)
+	model.Schedule = newAgentTaskScheduleDataSourceModel(task.Schedule)
+}
+
+// agentTaskConfigurationDataSourceAttribute is shared by the singular and
+// plural agent task data sources.
+func agentTaskConfigurationDataSourceAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "CLASSIFIER task configuration.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"classification_type": schema.Int64Attribute{
+				Description: "Classification engine identifier.",
+				Computed:    true,
+			},
+			"sample_strategy": schema.StringAttribute{
+				Description: "Sampling strategy used when collecting data for classification.",
+				Computed:    true,
+			},
+			"collection_name": schema.StringAttribute{
+				Description: "Name of the classifier collection to use.",
+				Computed:    true,
+			},
+			"ssl_config": schema.SingleNestedAttribute{
+				Description: "SSL/TLS configuration used when connecting to the repository.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Description: "Whether SSL/TLS is enabled for the connection.",
+						Computed:    true,
+					},
+					"hostname_in_certificate": schema.StringAttribute{
+						Description: "Expected hostname in the server certificate.",
+						Computed:    true,
+					},
+					"trust_server_certificate": schema.BoolAttribute{
+						Description: "Whether to trust the server certificate without validation.",
+						Computed:    true,
+					},
+					"trust_store_password_arn": schema.StringAttribute{
+						Description: "ARN of the secret holding the trust store password.",
+						Computed:    true,
+					},
+					"trust_store_path": schema.StringAttribute{
+						Description: "Path to the trust store used to validate the server certificate.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func agentTaskScheduleDataSourceAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Schedule controlling when the task runs.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "Schedule type (e.g. CRON).",
+				Computed:    true,
+			},
+			"value": schema.StringAttribute{
+				Description: "Cron expression (5 fields: minute hour dom month dow).",
+				Computed:    true,
+			},
+			"max_duration": schema.StringAttribute{
+				Description: "ISO 8601 duration capping how long a single run may take.",
+				Computed:    true,
+			},
+			"timezone": schema.StringAttribute{
+				Description: "IANA timezone name the cron expression is evaluated in.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func newAgentTaskConfigurationDataSourceModel(configuration client.AgentTaskConfiguration) *AgentTaskConfigurationDataSourceModel {
 	config := &AgentTaskConfigurationDataSourceModel{
-		SampleStrategy: types.StringValue(task.Configuration.SampleStrategy),
-		CollectionName: types.StringValue(task.Configuration.CollectionName),
+		SampleStrategy: types.StringValue(configuration.SampleStrategy),
+		CollectionName: types.StringValue(configuration.CollectionName),
 	}
 
-	if task.Configuration.ClassificationType != nil {
-		config.ClassificationType = types.Int64Value(int64(*task.Configuration.ClassificationType))
+	if configuration.ClassificationType != nil {
+		config.ClassificationType = types.Int64Value(int64(*configuration.ClassificationType))
 	} else {
 		config.ClassificationType = types.Int64Null()
 	}
 
-	if task.Configuration.SslConfig != nil {
+	if configuration.SslConfig != nil {
 		config.SslConfig = &SslConfigDataSourceModel{
-			Enabled:                types.BoolValue(task.Configuration.SslConfig.Enabled),
-			HostnameInCertificate:  types.StringValue(task.Configuration.SslConfig.HostnameInCertificate),
-			TrustServerCertificate: types.BoolValue(task.Configuration.SslConfig.TrustServerCertificate),
-			TrustStorePasswordARN:  types.StringValue(task.Configuration.SslConfig.TrustStorePasswordARN),
-			TrustStorePath:         types.StringValue(task.Configuration.SslConfig.TrustStorePath),
+			Enabled:                types.BoolValue(configuration.SslConfig.Enabled),
+			HostnameInCertificate:  types.StringValue(configuration.SslConfig.HostnameInCertificate),
+			TrustServerCertificate: types.BoolValue(configuration.SslConfig.TrustServerCertificate),
+			TrustStorePasswordARN:  types.StringValue(configuration.SslConfig.TrustStorePasswordARN),
+			TrustStorePath:         types.StringValue(configuration.SslConfig.TrustStorePath),
 		}
 	}
 
-	model.Configuration = config
+	return config
+}
 
-	model.Schedule = &AgentTaskScheduleDataSourceModel{
-		Type:        types.StringValue(task.Schedule.Type),
-		Value:       types.StringValue(task.Schedule.Value),
-		MaxDuration: types.StringValue(task.Schedule.MaxDuration),
-		Timezone:    types.StringValue(task.Schedule.Timezone),
+func newAgentTaskScheduleDataSourceModel(schedule client.AgentTaskSchedule) *AgentTaskScheduleDataSourceModel {
+	return &AgentTaskScheduleDataSourceModel{
+		Type:        types.StringValue(schedule.Type),
+		Value:       types.StringValue(schedule.Value),
+		MaxDuration: types.StringValue(schedule.MaxDuration),
+		Timezone:    types.StringValue(schedule.Timezone),
 	}
 }
@@ -0,0 +1,238 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &AgentTasksDataSource{}
+
+func NewAgentTasksDataSource() datasource.DataSource {
+	return &AgentTasksDataSource{}
+}
+
+type AgentTasksDataSource struct {
+	client *client.Client
+}
+
+type AgentTasksDataSourceModel struct {
+	AgentID  types.String                    `tfsdk:"agent_id"`
+	RepoName types.String                    `tfsdk:"repo_name"`
+	IDs      []types.String                  `tfsdk:"ids"`
+	Tasks    []AgentTasksDataSourceTaskModel `tfsdk:"tasks"`
+}
+
+type AgentTasksDataSourceTaskModel struct {
+	ID            types.String                           `tfsdk:"id"`
+	AgentID       types.String                           `tfsdk:"agent_id"`
+	Name          types.String                           `tfsdk:"name"`
+	Description   types.String                           `tfsdk:"description"`
+	RepoName      types.String                           `tfsdk:"repo_name"`
+	ServiceUser   types.String                           `tfsdk:"service_user"`
+	Configuration *AgentTaskConfigurationDataSourceModel `tfsdk:"configuration"`
+	Schedule      *AgentTaskScheduleDataSourceModel      `tfsdk:"schedule"`
+	CreatedAt     types.String                           `tfsdk:"created_at"`
+	UpdatedAt     types.String                           `tfsdk:"updated_at"`
+}
+
+func (d *AgentTasksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_tasks"
+}
+
+func (d *AgentTasksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for listing agent tasks, optionally filtered by agent and target repository. " +
+			"When agent_id is not set, the tasks of every agent in the organization are listed.",
+		Attributes: map[string]schema.Attribute{
+			"agent_id": schema.StringAttribute{
+				Description: "Only return tasks of the agent with this UUID.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(service.UUIDv4Regex),
+						"must be a valid UUIDv4",
+					),
+				},
+			},
+			"repo_name": schema.StringAttribute{
+				Description: "Only return tasks that run against this repository.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"ids": schema.ListAttribute{
+				Description: "IDs of the matching tasks, in the same order as tasks.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"tasks": schema.ListNestedAttribute{
+				Description: "Matching tasks, sorted by agent ID, name and ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Task UUID.",
+							Computed:    true,
+						},
+						"agent_id": schema.StringAttribute{
+							Description: "UUID of the agent this task belongs to.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Human-readable name for the task.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the task.",
+							Computed:    true,
+						},
+						"repo_name": schema.StringAttribute{
+							Description: "Name of the target repository this task runs against.",
+							Computed:    true,
+						},
+						"service_user": schema.StringAttribute{
+							Description: "Username of the service user the agent authenticates as when connecting to the repository.",
+							Computed:    true,
+						},
+						"configuration": agentTaskConfigurationDataSourceAttribute(),
+						"schedule":      agentTaskScheduleDataSourceAttribute(),
+						"created_at": schema.StringAttribute{
+							Description: "Creation timestamp.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "Last update timestamp.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AgentTasksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *AgentTasksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config AgentTasksDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var agentIDs []string
+
+	if !config.AgentID.IsNull() {
+		agentIDs = []string{config.AgentID.ValueString()}
+	} else {
+		agents, err := d.client.ListAgents()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error listing agents",
+				"Could not list agents, unexpected error: "+err.Error(),
+			)
+
+			return
+		}
+
+		for _, a := range agents {
+			agentIDs = append(agentIDs, a.ID)
+		}
+	}
+
+	var tasks []client.AgentTask
+
+	for _, agentID := range agentIDs {
+		agentTasks, err := d.client.ListAgentTasks(agentID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error listing agent tasks",
+				fmt.Sprintf("Could not list tasks of agent %s: %s", agentID, err.Error()),
+			)
+
+			return
+		}
+
+		// An explicitly requested agent must exist; one that disappeared
+		// between listing agents and listing its tasks is skipped
+		if agentTasks == nil && !config.AgentID.IsNull() {
+			resp.Diagnostics.AddError(
+				"Agent not found",
+				fmt.Sprintf("Agent '%s' does not exist.", agentID),
+			)
+
+			return
+		}
+
+		tasks = append(tasks, agentTasks...)
+	}
+
+	sort.Slice(tasks, func(i, j int) bool {
+		if tasks[i].AgentID != tasks[j].AgentID {
+			return tasks[i].AgentID < tasks[j].AgentID
+		}
+
+		if tasks[i].Name != tasks[j].Name {
+			return tasks[i].Name < tasks[j].Name
+		}
+
+		return tasks[i].ID < tasks[j].ID
+	})
+
+	config.IDs = []types.String{}
+	config.Tasks = []AgentTasksDataSourceTaskModel{}
+
+	for _, task := range tasks {
+		if !config.RepoName.IsNull() && task.RepoName != config.RepoName.ValueString() {
+			continue
+		}
+
+		config.IDs = append(config.IDs, types.StringValue(task.ID))
+		config.Tasks = append(config.Tasks, AgentTasksDataSourceTaskModel{
+			ID:            types.StringValue(task.ID),
+			AgentID:       types.StringValue(task.AgentID),
+			Name:          types.StringValue(task.Name),
+			Description:   types.StringValue(task.Description),
+			RepoName:      types.StringValue(task.RepoName),
+			ServiceUser:   types.StringValue(task.ServiceUser),
+			Configuration: newAgentTaskConfigurationDataSourceModel(task.Configuration),
+			Schedule:      newAgentTaskScheduleDataSourceModel(task.Schedule),
+			CreatedAt:     types.StringValue(task.CreatedAt),
+			UpdatedAt:     types.StringValue(task.UpdatedAt),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent_test
+
+import (
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAgentTasksDataSource_basic(t *testing.T) {
+	resourceName := "altr_agent_task.test"
+	prefix := acctest.RandomWithPrefixUnderscoreMaxLength("task_test", 24)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAgentTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentTaskResourceConfig_basic(prefix, "0 0 * * *") + `
+data "altr_agent_tasks" "by_agent" {
+  agent_id = altr_agent_task.test.agent_id
+}
+
+data "altr_agent_tasks" "by_repo" {
+  repo_name = altr_agent_task.test.repo_name
+}
+
+data "altr_agent_tasks" "other_repo" {
+  agent_id  = altr_agent_task.test.agent_id
+  repo_name = "not_${altr_agent_task.test.repo_name}"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.altr_agent_tasks.by_agent", "tasks.#", "1"),
+					resource.TestCheckResourceAttrPair("data.altr_agent_tasks.by_agent", "ids.0", resourceName, "id"),
+					resource.TestCheckResourceAttrPair("data.altr_agent_tasks.by_agent", "tasks.0.name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair("data.altr_agent_tasks.by_agent", "tasks.0.service_user", resourceName, "service_user"),
+					resource.TestCheckResourceAttr("data.altr_agent_tasks.by_agent", "tasks.0.configuration.classification_type", "5"),
+					resource.TestCheckResourceAttr("data.altr_agent_tasks.by_agent", "tasks.0.schedule.type", "CRON"),
+					resource.TestCheckResourceAttr("data.altr_agent_tasks.by_agent", "tasks.0.schedule.value", "0 0 * * *"),
+					resource.TestCheckResourceAttr("data.altr_agent_tasks.by_repo", "tasks.#", "1"),
+					resource.TestCheckResourceAttrPair("data.altr_agent_tasks.by_repo", "tasks.0.agent_id", resourceName, "agent_id"),
+					resource.TestCheckResourceAttr("data.altr_agent_tasks.other_repo", "tasks.#", "0"),
+				),
+			},
+		},
+	})
+}
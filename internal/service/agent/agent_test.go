@@ -85,6 +85,8 @@ func TestAccAgentResource_twoPublicKeys(t *testing.T) {
 					testAccCheckAgentExists(resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "public_key_1"),
 					resource.TestCheckResourceAttrSet(resourceName, "public_key_2"),
+					resource.TestCheckResourceAttrSet(resourceName, "public_key_1_fingerprint"),
+					resource.TestCheckResourceAttrSet(resourceName, "public_key_2_fingerprint"),
 				),
 			},
 		},
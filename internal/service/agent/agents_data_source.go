@@ -0,0 +1,212 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &AgentsDataSource{}
+
+func NewAgentsDataSource() datasource.DataSource {
+	return &AgentsDataSource{}
+}
+
+type AgentsDataSource struct {
+	client *client.Client
+}
+
+type AgentsDataSourceModel struct {
+	Type      types.String                 `tfsdk:"type"`
+	Name      types.String                 `tfsdk:"name"`
+	NameRegex types.String                 `tfsdk:"name_regex"`
+	IDs       []types.String               `tfsdk:"ids"`
+	Agents    []AgentsDataSourceAgentModel `tfsdk:"agents"`
+}
+
+type AgentsDataSourceAgentModel struct {
+	ID           types.String `tfsdk:"id"`
+	Type         types.String `tfsdk:"type"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	DataPlaneURL types.String `tfsdk:"data_plane_url"`
+	TaskCount    types.Int64  `tfsdk:"task_count"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+func (d *AgentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agents"
+}
+
+func (d *AgentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for listing the agents in the organization, optionally filtered. All filters that are set must match.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "Only return agents of this type (CLASSIFIER).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("CLASSIFIER"),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Only return the agent with exactly this name.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return agents whose name matches this RE2 regular expression.",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "IDs of the matching agents, sorted by name.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"agents": schema.ListNestedAttribute{
+				Description: "Matching agents, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Agent UUID.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Agent type (e.g. CLASSIFIER).",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Human-readable name for the agent.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the agent.",
+							Computed:    true,
+						},
+						"data_plane_url": schema.StringAttribute{
+							Description: "URL of the data plane this agent connects to.",
+							Computed:    true,
+						},
+						"task_count": schema.Int64Attribute{
+							Description: "Number of tasks currently assigned to this agent.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Creation timestamp.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "Last update timestamp.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AgentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *AgentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config AgentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !config.NameRegex.IsNull() {
+		var err error
+
+		nameRegex, err = regexp.Compile(config.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Configuration",
+				"name_regex is not a valid regular expression: "+err.Error(),
+			)
+
+			return
+		}
+	}
+
+	agents, err := d.client.ListAgents()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing agents",
+			"Could not list agents, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	sort.Slice(agents, func(i, j int) bool {
+		return agents[i].Name < agents[j].Name
+	})
+
+	config.IDs = []types.String{}
+	config.Agents = []AgentsDataSourceAgentModel{}
+
+	for _, a := range agents {
+		if !config.Type.IsNull() && a.Type != config.Type.ValueString() {
+			continue
+		}
+
+		if !config.Name.IsNull() && a.Name != config.Name.ValueString() {
+			continue
+		}
+
+		if nameRegex != nil && !nameRegex.MatchString(a.Name) {
+			continue
+		}
+
+		config.IDs = append(config.IDs, types.StringValue(a.ID))
+		config.Agents = append(config.Agents, AgentsDataSourceAgentModel{
+			ID:           types.StringValue(a.ID),
+			Type:         types.StringValue(a.Type),
+			Name:         types.StringValue(a.Name),
+			Description:  types.StringValue(a.Description),
+			DataPlaneURL: types.StringValue(a.DataPlaneURL),
+			TaskCount:    types.Int64Value(int64(a.TaskCount)),
+			CreatedAt:    types.StringValue(a.CreatedAt),
+			UpdatedAt:    types.StringValue(a.UpdatedAt),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
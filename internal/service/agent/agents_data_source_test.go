@@ -0,0 +1,62 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAgentsDataSource_basic(t *testing.T) {
+	resourceName := "altr_agent.test"
+	name := acctest.RandomWithPrefixUnderscoreMaxLength("agent_test", 64)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAgentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentResourceConfig_basic(name) + `
+data "altr_agents" "by_name" {
+  type = "CLASSIFIER"
+  name = altr_agent.test.name
+}
+
+data "altr_agents" "by_regex" {
+  name_regex = "^${altr_agent.test.name}$"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.altr_agents.by_name", "agents.#", "1"),
+					resource.TestCheckResourceAttrPair("data.altr_agents.by_name", "ids.0", resourceName, "id"),
+					resource.TestCheckResourceAttrPair("data.altr_agents.by_name", "agents.0.type", resourceName, "type"),
+					resource.TestCheckResourceAttr("data.altr_agents.by_name", "agents.0.task_count", "0"),
+					resource.TestCheckResourceAttr("data.altr_agents.by_regex", "agents.#", "1"),
+					resource.TestCheckResourceAttrPair("data.altr_agents.by_regex", "agents.0.name", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAgentsDataSource_invalidNameRegex(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "altr_agents" "test" {
+  name_regex = "("
+}
+`,
+				ExpectError: regexp.MustCompile(`name_regex is not a valid regular expression`),
+			},
+		},
+	})
+}
@@ -0,0 +1,484 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package connection
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ resource.Resource                     = &SnowflakeConnectionResource{}
+	_ resource.ResourceWithImportState      = &SnowflakeConnectionResource{}
+	_ resource.ResourceWithConfigValidators = &SnowflakeConnectionResource{}
+)
+
+func NewSnowflakeConnectionResource() resource.Resource {
+	return &SnowflakeConnectionResource{}
+}
+
+type SnowflakeConnectionResource struct {
+	client *client.Client
+}
+
+type SnowflakeConnectionResourceModel struct {
+	ID        types.Int64           `tfsdk:"id"`
+	Name      types.String          `tfsdk:"name"`
+	Account   types.String          `tfsdk:"account"`
+	Role      types.String          `tfsdk:"role"`
+	Warehouse types.String          `tfsdk:"warehouse"`
+	KeyPair   basetypes.ObjectValue `tfsdk:"key_pair"`
+	OAuth     basetypes.ObjectValue `tfsdk:"oauth"`
+	CreatedAt types.String          `tfsdk:"created_at"`
+	UpdatedAt types.String          `tfsdk:"updated_at"`
+}
+
+var credentialProvidersObjectType = types.ObjectType{AttrTypes: service.CredentialProviderAttrTypes}
+
+var keyPairAttrTypes = map[string]attr.Type{
+	"username":    types.StringType,
+	"private_key": credentialProvidersObjectType,
+	"passphrase":  credentialProvidersObjectType,
+}
+
+var oauthAttrTypes = map[string]attr.Type{
+	"client_id":      types.StringType,
+	"client_secret":  credentialProvidersObjectType,
+	"token_endpoint": types.StringType,
+}
+
+func (r *SnowflakeConnectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snowflake_connection"
+}
+
+func (r *SnowflakeConnectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a connection ALTR uses to manage a Snowflake account. Exactly one of key_pair or oauth must be configured, " +
+			"and each secret is read from exactly one credential provider. The id is referenced by the connection_ids of access management Snowflake policies.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "Numeric ID of the connection.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the connection.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"account": schema.StringAttribute{
+				Description: "Snowflake account identifier (e.g. myorg-myaccount or xy12345.us-east-1).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "Snowflake role ALTR assumes.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"warehouse": schema.StringAttribute{
+				Description: "Snowflake virtual warehouse ALTR runs queries on.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"key_pair": schema.SingleNestedAttribute{
+				Description: "Key pair authentication.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Description: "Snowflake user the key pair belongs to.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 255),
+						},
+					},
+					"private_key": schema.SingleNestedAttribute{
+						Description: "Credential provider holding the PEM-encoded private key.",
+						Required:    true,
+						Attributes:  service.CredentialProviderSchemaAttributes(),
+					},
+					"passphrase": schema.SingleNestedAttribute{
+						Description: "Credential provider holding the passphrase of an encrypted private key.",
+						Optional:    true,
+						Attributes:  service.CredentialProviderSchemaAttributes(),
+					},
+				},
+			},
+			"oauth": schema.SingleNestedAttribute{
+				Description: "OAuth client credentials authentication.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"client_id": schema.StringAttribute{
+						Description: "OAuth client ID.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 255),
+						},
+					},
+					"client_secret": schema.SingleNestedAttribute{
+						Description: "Credential provider holding the OAuth client secret.",
+						Required:    true,
+						Attributes:  service.CredentialProviderSchemaAttributes(),
+					},
+					"token_endpoint": schema.StringAttribute{
+						Description: "Token endpoint of the authorization server. Defaults to the Snowflake OAuth endpoint of the account.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 2048),
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Last update timestamp.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *SnowflakeConnectionResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("key_pair"),
+			path.MatchRoot("oauth"),
+		),
+	}
+}
+
+func (r *SnowflakeConnectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+func (r *SnowflakeConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SnowflakeConnectionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateCredentialProviders(&plan); err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+
+		return
+	}
+
+	input := client.CreateSnowflakeConnectionInput{
+		Name:      plan.Name.ValueString(),
+		Account:   plan.Account.ValueString(),
+		Role:      plan.Role.ValueString(),
+		Warehouse: plan.Warehouse.ValueString(),
+		KeyPair:   keyPairFromObject(plan.KeyPair),
+		OAuth:     oauthFromObject(plan.OAuth),
+	}
+
+	connection, err := r.client.CreateSnowflakeConnection(input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Snowflake connection",
+			"Could not create Snowflake connection, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	mapConnectionToModel(connection, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *SnowflakeConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SnowflakeConnectionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection, err := r.client.GetSnowflakeConnection(state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Snowflake connection",
+			"Could not read Snowflake connection "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+
+		return
+	}
+
+	// If the connection doesn't exist, remove it from state
+	if connection == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	mapConnectionToModel(connection, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *SnowflakeConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		plan  SnowflakeConnectionResourceModel
+		state SnowflakeConnectionResourceModel
+	)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateCredentialProviders(&plan); err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+
+		return
+	}
+
+	// The connection keeps its ID, so policies that reference it are left intact
+	input := client.UpdateSnowflakeConnectionInput{
+		Name:      plan.Name.ValueString(),
+		Account:   plan.Account.ValueString(),
+		Role:      plan.Role.ValueString(),
+		Warehouse: plan.Warehouse.ValueString(),
+		KeyPair:   keyPairFromObject(plan.KeyPair),
+		OAuth:     oauthFromObject(plan.OAuth),
+	}
+
+	connection, err := r.client.UpdateSnowflakeConnection(state.ID.ValueInt64(), input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Snowflake connection",
+			"Could not update Snowflake connection, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	mapConnectionToModel(connection, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *SnowflakeConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SnowflakeConnectionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSnowflakeConnection(state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Snowflake connection",
+			"Could not delete Snowflake connection. Note: A connection cannot be deleted while policies reference it. Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *SnowflakeConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected the numeric ID of the Snowflake connection, got: "+req.ID,
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// validateCredentialProviders ensures every configured secret is read from exactly one credential provider
+func validateCredentialProviders(model *SnowflakeConnectionResourceModel) error {
+	secrets := map[string]basetypes.ObjectValue{}
+
+	if !model.KeyPair.IsNull() && !model.KeyPair.IsUnknown() {
+		secrets["key_pair.private_key"] = model.KeyPair.Attributes()["private_key"].(basetypes.ObjectValue)
+		secrets["key_pair.passphrase"] = model.KeyPair.Attributes()["passphrase"].(basetypes.ObjectValue)
+	}
+
+	if !model.OAuth.IsNull() && !model.OAuth.IsUnknown() {
+		secrets["oauth.client_secret"] = model.OAuth.Attributes()["client_secret"].(basetypes.ObjectValue)
+	}
+
+	for name, secret := range secrets {
+		if secret.IsNull() || secret.IsUnknown() {
+			continue
+		}
+
+		attrs := secret.Attributes()
+
+		err := service.ValidateSingleCredentialProvider(
+			attrs[service.CredentialProviderAWSSecretsManager].(basetypes.ObjectValue),
+			attrs[service.CredentialProviderAzureKeyVault].(basetypes.ObjectValue),
+			attrs[service.CredentialProviderEnvironmentVariable].(basetypes.ObjectValue),
+			attrs[service.CredentialProviderSecretFile].(basetypes.ObjectValue),
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func credentialProvidersFromObject(obj basetypes.ObjectValue) client.CredentialProviders {
+	attrs := obj.Attributes()
+
+	var providers client.CredentialProviders
+
+	providers.AWSSecretsManager, providers.AzureKeyVault, providers.EnvironmentVariable, providers.SecretFile = service.CredentialProvidersFromObjects(
+		attrs[service.CredentialProviderAWSSecretsManager].(basetypes.ObjectValue),
+		attrs[service.CredentialProviderAzureKeyVault].(basetypes.ObjectValue),
+		attrs[service.CredentialProviderEnvironmentVariable].(basetypes.ObjectValue),
+		attrs[service.CredentialProviderSecretFile].(basetypes.ObjectValue),
+	)
+
+	return providers
+}
+
+func credentialProvidersToObject(providers client.CredentialProviders) basetypes.ObjectValue {
+	aws, azure, envVar, secretFile := service.CredentialProvidersToObjects(
+		providers.AWSSecretsManager, providers.AzureKeyVault, providers.EnvironmentVariable, providers.SecretFile,
+	)
+
+	return basetypes.NewObjectValueMust(service.CredentialProviderAttrTypes, map[string]attr.Value{
+		service.CredentialProviderAWSSecretsManager:   aws,
+		service.CredentialProviderAzureKeyVault:       azure,
+		service.CredentialProviderEnvironmentVariable: envVar,
+		service.CredentialProviderSecretFile:          secretFile,
+	})
+}
+
+func keyPairFromObject(obj basetypes.ObjectValue) *client.SnowflakeKeyPairAuth {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+
+	attrs := obj.Attributes()
+
+	keyPair := &client.SnowflakeKeyPairAuth{
+		Username:   attrs["username"].(types.String).ValueString(),
+		PrivateKey: credentialProvidersFromObject(attrs["private_key"].(basetypes.ObjectValue)),
+	}
+
+	if passphrase := attrs["passphrase"].(basetypes.ObjectValue); !passphrase.IsNull() {
+		providers := credentialProvidersFromObject(passphrase)
+		keyPair.Passphrase = &providers
+	}
+
+	return keyPair
+}
+
+func oauthFromObject(obj basetypes.ObjectValue) *client.SnowflakeOAuthAuth {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+
+	attrs := obj.Attributes()
+
+	return &client.SnowflakeOAuthAuth{
+		ClientID:      attrs["client_id"].(types.String).ValueString(),
+		ClientSecret:  credentialProvidersFromObject(attrs["client_secret"].(basetypes.ObjectValue)),
+		TokenEndpoint: attrs["token_endpoint"].(types.String).ValueString(),
+	}
+}
+
+func mapConnectionToModel(connection *client.SnowflakeConnection, model *SnowflakeConnectionResourceModel) {
+	model.ID = types.Int64Value(connection.ID)
+	model.Name = types.StringValue(connection.Name)
+	model.Account = types.StringValue(connection.Account)
+	model.Role = types.StringValue(connection.Role)
+	model.Warehouse = types.StringValue(connection.Warehouse)
+	model.CreatedAt = types.StringValue(connection.CreatedAt)
+	model.UpdatedAt = types.StringValue(connection.UpdatedAt)
+
+	model.KeyPair = basetypes.NewObjectNull(keyPairAttrTypes)
+	if connection.KeyPair != nil {
+		passphrase := basetypes.NewObjectNull(service.CredentialProviderAttrTypes)
+		if connection.KeyPair.Passphrase != nil {
+			passphrase = credentialProvidersToObject(*connection.KeyPair.Passphrase)
+		}
+
+		model.KeyPair = basetypes.NewObjectValueMust(keyPairAttrTypes, map[string]attr.Value{
+			"username":    types.StringValue(connection.KeyPair.Username),
+			"private_key": credentialProvidersToObject(connection.KeyPair.PrivateKey),
+			"passphrase":  passphrase,
+		})
+	}
+
+	model.OAuth = basetypes.NewObjectNull(oauthAttrTypes)
+	if connection.OAuth != nil {
+		tokenEndpoint := types.StringNull()
+		if connection.OAuth.TokenEndpoint != "" {
+			tokenEndpoint = types.StringValue(connection.OAuth.TokenEndpoint)
+		}
+
+		model.OAuth = basetypes.NewObjectValueMust(oauthAttrTypes, map[string]attr.Value{
+			"client_id":      types.StringValue(connection.OAuth.ClientID),
+			"client_secret":  credentialProvidersToObject(connection.OAuth.ClientSecret),
+			"token_endpoint": tokenEndpoint,
+		})
+	}
+}
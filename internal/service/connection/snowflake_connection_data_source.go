@@ -0,0 +1,185 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package connection
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SnowflakeConnectionDataSource{}
+
+func NewSnowflakeConnectionDataSource() datasource.DataSource {
+	return &SnowflakeConnectionDataSource{}
+}
+
+type SnowflakeConnectionDataSource struct {
+	client *client.Client
+}
+
+type SnowflakeConnectionDataSourceModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Account   types.String `tfsdk:"account"`
+	Role      types.String `tfsdk:"role"`
+	Warehouse types.String `tfsdk:"warehouse"`
+	AuthType  types.String `tfsdk:"auth_type"`
+	Username  types.String `tfsdk:"username"`
+	ClientID  types.String `tfsdk:"client_id"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (d *SnowflakeConnectionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snowflake_connection"
+}
+
+func (d *SnowflakeConnectionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for looking up a Snowflake connection by name, e.g. to reference connections configured in the ALTR UI " +
+			"from access management Snowflake policies. Credential provider settings are not returned.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the connection.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"id": schema.Int64Attribute{
+				Description: "Numeric ID of the connection.",
+				Computed:    true,
+			},
+			"account": schema.StringAttribute{
+				Description: "Snowflake account identifier.",
+				Computed:    true,
+			},
+			"role": schema.StringAttribute{
+				Description: "Snowflake role ALTR assumes.",
+				Computed:    true,
+			},
+			"warehouse": schema.StringAttribute{
+				Description: "Snowflake virtual warehouse ALTR runs queries on.",
+				Computed:    true,
+			},
+			"auth_type": schema.StringAttribute{
+				Description: "Authentication method of the connection: key_pair or oauth.",
+				Computed:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Snowflake user of a key_pair connection.",
+				Computed:    true,
+			},
+			"client_id": schema.StringAttribute{
+				Description: "OAuth client ID of an oauth connection.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation timestamp.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Last update timestamp.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *SnowflakeConnectionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *SnowflakeConnectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SnowflakeConnectionDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connections, err := d.client.ListSnowflakeConnections()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing Snowflake connections",
+			"Could not list Snowflake connections, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	var connection *client.SnowflakeConnection
+	var matches []string
+
+	for i := range connections {
+		if connections[i].Name == config.Name.ValueString() {
+			connection = &connections[i]
+			matches = append(matches, strconv.FormatInt(connections[i].ID, 10))
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"Snowflake connection not found",
+			fmt.Sprintf("Snowflake connection '%s' does not exist.", config.Name.ValueString()),
+		)
+
+		return
+	}
+
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Multiple Snowflake connections found",
+			fmt.Sprintf("%d Snowflake connections are named '%s' (IDs: %s). Rename the duplicates so the name is unique.", len(matches), config.Name.ValueString(), strings.Join(matches, ", ")),
+		)
+
+		return
+	}
+
+	config.ID = types.Int64Value(connection.ID)
+	config.Account = types.StringValue(connection.Account)
+	config.Role = types.StringValue(connection.Role)
+	config.Warehouse = types.StringValue(connection.Warehouse)
+	config.CreatedAt = types.StringValue(connection.CreatedAt)
+	config.UpdatedAt = types.StringValue(connection.UpdatedAt)
+	config.AuthType = types.StringNull()
+	config.Username = types.StringNull()
+	config.ClientID = types.StringNull()
+
+	switch {
+	case connection.KeyPair != nil:
+		config.AuthType = types.StringValue("key_pair")
+		config.Username = types.StringValue(connection.KeyPair.Username)
+	case connection.OAuth != nil:
+		config.AuthType = types.StringValue("oauth")
+		config.ClientID = types.StringValue(connection.OAuth.ClientID)
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
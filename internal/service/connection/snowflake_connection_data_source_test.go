@@ -0,0 +1,77 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package connection_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSnowflakeConnectionDataSource_byName(t *testing.T) {
+	resourceName := "altr_snowflake_connection.test"
+	dataSourceName := "data.altr_snowflake_connection.test"
+	rName := acctest.RandomWithPrefixUnderscore("tf_acc_conn")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSnowflakeConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnowflakeConnectionResourceConfig_keyPair(rName, "COMPUTE_WH") + `
+data "altr_snowflake_connection" "test" {
+  name = altr_snowflake_connection.test.name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "account", resourceName, "account"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "warehouse", resourceName, "warehouse"),
+					resource.TestCheckResourceAttr(dataSourceName, "auth_type", "key_pair"),
+					resource.TestCheckResourceAttr(dataSourceName, "username", "ALTR_SERVICE"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "client_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSnowflakeConnectionDataSource_duplicateName(t *testing.T) {
+	rName := acctest.RandomWithPrefixUnderscore("tf_acc_conn")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSnowflakeConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnowflakeConnectionResourceConfig_keyPair(rName, "COMPUTE_WH") + `
+resource "altr_snowflake_connection" "duplicate" {
+  name      = altr_snowflake_connection.test.name
+  account   = "myorg-otheraccount"
+  role      = "ALTR_ROLE"
+  warehouse = "COMPUTE_WH"
+
+  key_pair = {
+    username = "ALTR_SERVICE"
+    private_key = {
+      secret_file = {
+        path = "snowflake_key.p8"
+      }
+    }
+  }
+}
+
+data "altr_snowflake_connection" "test" {
+  name = altr_snowflake_connection.duplicate.name
+}
+`,
+				ExpectError: regexp.MustCompile(`Multiple Snowflake connections found`),
+			},
+		},
+	})
+}
@@ -0,0 +1,244 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package connection_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSnowflakeConnectionResource_keyPair(t *testing.T) {
+	resourceName := "altr_snowflake_connection.test"
+	rName := acctest.RandomWithPrefixUnderscore("tf_acc_conn")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSnowflakeConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnowflakeConnectionResourceConfig_keyPair(rName, "COMPUTE_WH"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnowflakeConnectionExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "key_pair.username", "ALTR_SERVICE"),
+					resource.TestCheckResourceAttr(resourceName, "key_pair.private_key.secret_file.path", "snowflake_key.p8"),
+					resource.TestCheckNoResourceAttr(resourceName, "oauth"),
+				),
+			},
+			{
+				Config: testAccSnowflakeConnectionResourceConfig_keyPair(rName, "REPORTING_WH"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnowflakeConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "warehouse", "REPORTING_WH"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSnowflakeConnectionResource_oauth(t *testing.T) {
+	resourceName := "altr_snowflake_connection.test"
+	rName := acctest.RandomWithPrefixUnderscore("tf_acc_conn")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSnowflakeConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnowflakeConnectionResourceConfig_oauth(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnowflakeConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "oauth.client_id", "altr-client"),
+					resource.TestCheckResourceAttr(resourceName, "oauth.client_secret.environment_variable.variable_name", "SNOWFLAKE_CLIENT_SECRET"),
+					resource.TestCheckNoResourceAttr(resourceName, "key_pair"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSnowflakeConnectionResource_authValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "altr_snowflake_connection" "test" {
+  name      = "no_auth"
+  account   = "myorg-myaccount"
+  role      = "ALTR_ROLE"
+  warehouse = "COMPUTE_WH"
+}
+`,
+				ExpectError: regexp.MustCompile(`Exactly one of these attributes must be configured: \[key_pair,oauth\]`),
+			},
+			{
+				Config: `
+resource "altr_snowflake_connection" "test" {
+  name      = "both_auth"
+  account   = "myorg-myaccount"
+  role      = "ALTR_ROLE"
+  warehouse = "COMPUTE_WH"
+
+  key_pair = {
+    username = "ALTR_SERVICE"
+    private_key = {
+      secret_file = {
+        path = "snowflake_key.p8"
+      }
+    }
+  }
+
+  oauth = {
+    client_id = "altr-client"
+    client_secret = {
+      environment_variable = {
+        variable_name = "SNOWFLAKE_CLIENT_SECRET"
+      }
+    }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Exactly one of these attributes must be configured: \[key_pair,oauth\]`),
+			},
+		},
+	})
+}
+
+func testAccCheckSnowflakeConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return fmt.Errorf("Invalid Snowflake connection ID %q: %w", rs.Primary.ID, err)
+		}
+
+		conn, err := testAccConnectionClient()
+		if err != nil {
+			return err
+		}
+
+		connection, err := conn.GetSnowflakeConnection(id)
+		if err != nil {
+			return err
+		}
+
+		if connection == nil {
+			return fmt.Errorf("Snowflake connection not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSnowflakeConnectionDestroy(s *terraform.State) error {
+	conn, err := testAccConnectionClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "altr_snowflake_connection" {
+			continue
+		}
+
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return fmt.Errorf("Invalid Snowflake connection ID %q: %w", rs.Primary.ID, err)
+		}
+
+		connection, err := conn.GetSnowflakeConnection(id)
+		if err != nil {
+			return err
+		}
+
+		if connection != nil {
+			return fmt.Errorf("Snowflake connection %d still exists", id)
+		}
+	}
+
+	return nil
+}
+
+// testAccConnectionClient builds an API client from the standard acceptance test
+// environment variables.
+func testAccConnectionClient() (*client.Client, error) {
+	conn, err := client.NewClient(
+		acctest.TestGetEnv("ALTR_ORG_ID", "test-org"),
+		acctest.TestGetEnv("ALTR_API_KEY", "test-key"),
+		acctest.TestGetEnv("ALTR_SECRET", "test-secret"),
+		acctest.TestGetEnv("ALTR_BASE_URL", ""),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create test client: %w", err)
+	}
+
+	return conn, nil
+}
+
+func testAccSnowflakeConnectionResourceConfig_keyPair(name, warehouse string) string {
+	return fmt.Sprintf(`
+resource "altr_snowflake_connection" "test" {
+  name      = %[1]q
+  account   = "myorg-myaccount"
+  role      = "ALTR_ROLE"
+  warehouse = %[2]q
+
+  key_pair = {
+    username = "ALTR_SERVICE"
+    private_key = {
+      secret_file = {
+        path = "snowflake_key.p8"
+      }
+    }
+  }
+}
+`, name, warehouse)
+}
+
+func testAccSnowflakeConnectionResourceConfig_oauth(name string) string {
+	return fmt.Sprintf(`
+resource "altr_snowflake_connection" "test" {
+  name      = %[1]q
+  account   = "myorg-myaccount"
+  role      = "ALTR_ROLE"
+  warehouse = "COMPUTE_WH"
+
+  oauth = {
+    client_id = "altr-client"
+    client_secret = {
+      environment_variable = {
+        variable_name = "SNOWFLAKE_CLIENT_SECRET"
+      }
+    }
+  }
+}
+`, name)
+}
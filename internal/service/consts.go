@@ -6,7 +6,12 @@ package service
 const (
 	UUIDv4Regex                    = `^[0-9a-f]{8}-[0-9a-f]{4}-[1-5][0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`
 	AlphanumericAndUnderscoreRegex = `^[a-zA-Z0-9_]+$`
-	HostnameRegexStringRFC1123     = `^([a-zA-Z0-9]{1}[a-zA-Z0-9-]{0,62}){1}(\.[a-zA-Z0-9]{1}[a-zA-Z0-9-]{0,62})*?$`
+)
+
+const (
+	DatabaseTypeSnowflake  = "Snowflake"
+	DatabaseTypeRedshift   = "Redshift"
+	DatabaseTypeDatabricks = "Databricks"
 )
 
 var OltpDatabaseTypes = []string{
@@ -15,3 +20,23 @@ var OltpDatabaseTypes = []string{
 	"MySQL",
 	"Postgres",
 }
+
+var WarehouseDatabaseTypes = []string{
+	DatabaseTypeSnowflake,
+	DatabaseTypeRedshift,
+	DatabaseTypeDatabricks,
+}
+
+// DatabaseTypes are all repository and sidecar listener types
+var DatabaseTypes = append(append([]string{}, OltpDatabaseTypes...), WarehouseDatabaseTypes...)
+
+// DefaultDatabasePorts are the ports used when a repository or listener does not set one
+var DefaultDatabasePorts = map[string]int64{
+	"Oracle":               1521,
+	"MSSQL":                1433,
+	"MySQL":                3306,
+	"Postgres":             5432,
+	DatabaseTypeSnowflake:  443,
+	DatabaseTypeRedshift:   5439,
+	DatabaseTypeDatabricks: 443,
+}
@@ -1,7 +1,7 @@
 // Copyright (c) ALTR Solutions, Inc.
 // SPDX-License-Identifier: Apache-2.0
 
-package repo
+package service
 
 import (
 	"errors"
@@ -15,10 +15,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
-// Credential providers are shared by repo users and service users: both must
-// configure exactly one of aws_secrets_manager, azure_key_vault,
-// environment_variable, or secret_file. The attribute types, schema, and
-// model<->client conversions live here so the two resources stay in sync.
+// Credential providers are shared by repo users, service users and Snowflake
+// connections: each must configure exactly one of aws_secrets_manager,
+// azure_key_vault, environment_variable, or secret_file. The attribute types,
+// schema, and model<->client conversions live here so the resources stay in sync.
+
+// Credential provider names, matching the attribute names of the providers
+const (
+	CredentialProviderAWSSecretsManager   = "aws_secrets_manager"
+	CredentialProviderAzureKeyVault       = "azure_key_vault"
+	CredentialProviderEnvironmentVariable = "environment_variable"
+	CredentialProviderSecretFile          = "secret_file"
+)
+
+var CredentialProviderTypes = []string{
+	CredentialProviderAWSSecretsManager,
+	CredentialProviderAzureKeyVault,
+	CredentialProviderEnvironmentVariable,
+	CredentialProviderSecretFile,
+}
 
 var awsAttrTypes = map[string]attr.Type{
 	"iam_role":     types.StringType,
@@ -38,9 +53,19 @@ var secretFileAttrTypes = map[string]attr.Type{
 	"path": types.StringType,
 }
 
-// credentialProviderSchemaAttributes returns the four mutually-exclusive
-// credential provider attributes shared by repo users and service users.
-func credentialProviderSchemaAttributes() map[string]schema.Attribute {
+// CredentialProviderAttrTypes are the attribute types of an object holding the four
+// credential provider attributes
+var CredentialProviderAttrTypes = map[string]attr.Type{
+	CredentialProviderAWSSecretsManager:   types.ObjectType{AttrTypes: awsAttrTypes},
+	CredentialProviderAzureKeyVault:       types.ObjectType{AttrTypes: azureAttrTypes},
+	CredentialProviderEnvironmentVariable: types.ObjectType{AttrTypes: envVarAttrTypes},
+	CredentialProviderSecretFile:          types.ObjectType{AttrTypes: secretFileAttrTypes},
+}
+
+// CredentialProviderSchemaAttributes returns the four mutually-exclusive
+// credential provider attributes shared by repo users, service users and
+// Snowflake connections.
+func CredentialProviderSchemaAttributes() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		"aws_secrets_manager": schema.SingleNestedAttribute{
 			Description: "AWS Secrets Manager credential provider.",
@@ -109,9 +134,31 @@ func credentialProviderSchemaAttributes() map[string]schema.Attribute {
 	}
 }
 
-// validateSingleCredentialProvider ensures exactly one of the four credential
+// CredentialProviderType returns the name of the configured credential provider
+// without exposing where the secret lives, or null if none is set.
+func CredentialProviderType(
+	aws *client.AWSSecretsManager,
+	azure *client.AzureKeyVault,
+	envVar *client.EnvironmentVariable,
+	secretFile *client.SecretFile,
+) types.String {
+	switch {
+	case aws != nil:
+		return types.StringValue(CredentialProviderAWSSecretsManager)
+	case azure != nil:
+		return types.StringValue(CredentialProviderAzureKeyVault)
+	case envVar != nil:
+		return types.StringValue(CredentialProviderEnvironmentVariable)
+	case secretFile != nil:
+		return types.StringValue(CredentialProviderSecretFile)
+	default:
+		return types.StringNull()
+	}
+}
+
+// ValidateSingleCredentialProvider ensures exactly one of the four credential
 // providers is configured.
-func validateSingleCredentialProvider(aws, azure, envVar, secretFile basetypes.ObjectValue) error {
+func ValidateSingleCredentialProvider(aws, azure, envVar, secretFile basetypes.ObjectValue) error {
 	count := 0
 
 	if !aws.IsNull() {
@@ -141,9 +188,9 @@ func validateSingleCredentialProvider(aws, azure, envVar, secretFile basetypes.O
 	return nil
 }
 
-// credentialProvidersFromObjects extracts the active credential provider from
+// CredentialProvidersFromObjects extracts the active credential provider from
 // the four nested objects, returning the client-side representations.
-func credentialProvidersFromObjects(aws, azure, envVar, secretFile basetypes.ObjectValue) (
+func CredentialProvidersFromObjects(aws, azure, envVar, secretFile basetypes.ObjectValue) (
 	*client.AWSSecretsManager,
 	*client.AzureKeyVault,
 	*client.EnvironmentVariable,
@@ -188,10 +235,10 @@ func credentialProvidersFromObjects(aws, azure, envVar, secretFile basetypes.Obj
 	return awsOut, azureOut, envVarOut, fileOut
 }
 
-// credentialProvidersToObjects converts the client-side credential providers
+// CredentialProvidersToObjects converts the client-side credential providers
 // back into the four nested object values for state, nulling out any that are
 // absent from the API response.
-func credentialProvidersToObjects(
+func CredentialProvidersToObjects(
 	aws *client.AWSSecretsManager,
 	azure *client.AzureKeyVault,
 	envVar *client.EnvironmentVariable,
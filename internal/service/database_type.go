@@ -0,0 +1,50 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DefaultPortForType returns a plan modifier that defaults an unconfigured port attribute
+// to the well-known port of the database type in the sibling type attribute
+func DefaultPortForType(typeAttribute string) planmodifier.Int64 {
+	return defaultPortForTypeModifier{typeAttribute: typeAttribute}
+}
+
+type defaultPortForTypeModifier struct {
+	typeAttribute string
+}
+
+func (m defaultPortForTypeModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("Defaults to the well-known port of the database type in %s.", m.typeAttribute)
+}
+
+func (m defaultPortForTypeModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m defaultPortForTypeModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	// Nothing to default on destroy or when the port is configured
+	if req.Plan.Raw.IsNull() || !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var databaseType types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(m.typeAttribute), &databaseType)...)
+
+	if resp.Diagnostics.HasError() || databaseType.IsUnknown() || databaseType.IsNull() {
+		return
+	}
+
+	if port, ok := DefaultDatabasePorts[databaseType.ValueString()]; ok {
+		resp.PlanValue = types.Int64Value(port)
+	}
+}
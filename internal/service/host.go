@@ -0,0 +1,120 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = HostType{}
+	_ basetypes.StringValuableWithSemanticEquals = HostValue{}
+)
+
+// HostType is a string type for repository and sidecar hosts that treats hostnames
+// differing only in case or a trailing dot, and equivalent IP address spellings, as equal
+type HostType struct {
+	basetypes.StringType
+}
+
+func (t HostType) Equal(o attr.Type) bool {
+	other, ok := o.(HostType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t HostType) String() string {
+	return "HostType"
+}
+
+func (t HostType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return HostValue{StringValue: in}, nil
+}
+
+func (t HostType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return HostValue{StringValue: stringValue}, nil
+}
+
+func (t HostType) ValueType(ctx context.Context) attr.Value {
+	return HostValue{}
+}
+
+// HostValue is the value of a HostType attribute
+type HostValue struct {
+	basetypes.StringValue
+}
+
+func (v HostValue) Equal(o attr.Value) bool {
+	other, ok := o.(HostValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v HostValue) Type(ctx context.Context) attr.Type {
+	return HostType{}
+}
+
+// StringSemanticEquals compares the normalized hosts, falling back to an exact
+// comparison when either value is not a valid host
+func (v HostValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(HostValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this to the provider developers.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	oldHost, oldErr := validation.NormalizeHost(v.ValueString())
+	newHost, newErr := validation.NormalizeHost(newValue.ValueString())
+	if oldErr == nil && newErr == nil {
+		return oldHost == newHost, diags
+	}
+
+	return v.ValueString() == newValue.ValueString(), diags
+}
+
+// NormalizedHost returns the canonical form of the host that is sent to the API,
+// or the value unchanged when it is not a valid host
+func (v HostValue) NormalizedHost() string {
+	host, err := validation.NormalizeHost(v.ValueString())
+	if err != nil {
+		return v.ValueString()
+	}
+
+	return host
+}
+
+// NewHostValue returns a known HostValue
+func NewHostValue(value string) HostValue {
+	return HostValue{StringValue: types.StringValue(value)}
+}
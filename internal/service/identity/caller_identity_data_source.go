@@ -0,0 +1,117 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package identity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CallerIdentityDataSource{}
+
+func NewCallerIdentityDataSource() datasource.DataSource {
+	return &CallerIdentityDataSource{}
+}
+
+type CallerIdentityDataSource struct {
+	client *client.Client
+}
+
+type CallerIdentityDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	OrgID       types.String `tfsdk:"org_id"`
+	APIKeyID    types.String `tfsdk:"api_key_id"`
+	BaseURL     types.String `tfsdk:"base_url"`
+	ExternalURL types.String `tfsdk:"external_api_url"`
+	SidecarURL  types.String `tfsdk:"sidecar_api_url"`
+}
+
+func (d *CallerIdentityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_caller_identity"
+}
+
+func (d *CallerIdentityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for retrieving the identity the provider authenticates as. " +
+			"Reading it makes an authenticated API call, so it fails when the credentials are rejected.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Organization ID, used as the data source ID.",
+				Computed:    true,
+			},
+			"org_id": schema.StringAttribute{
+				Description: "ALTR organization ID the provider is configured for.",
+				Computed:    true,
+			},
+			"api_key_id": schema.StringAttribute{
+				Description: "ID of the API key the provider authenticates with. The secret is never returned.",
+				Computed:    true,
+			},
+			"base_url": schema.StringAttribute{
+				Description: "Resolved ALTR base URL.",
+				Computed:    true,
+			},
+			"external_api_url": schema.StringAttribute{
+				Description: "Resolved URL of the external API gateway, used for policies.",
+				Computed:    true,
+			},
+			"sidecar_api_url": schema.StringAttribute{
+				Description: "Resolved URL of the sidecar control API gateway, used for sidecars, repositories and agents.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *CallerIdentityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CallerIdentityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config CallerIdentityDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := d.client.ValidateCredentials(); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ALTR Credentials",
+			"Could not authenticate with the ALTR API: "+err.Error(),
+		)
+
+		return
+	}
+
+	config.ID = types.StringValue(d.client.OrgID())
+	config.OrgID = types.StringValue(d.client.OrgID())
+	config.APIKeyID = types.StringValue(d.client.APIKeyID())
+	config.BaseURL = types.StringValue(d.client.BaseURL())
+	config.ExternalURL = types.StringValue(d.client.ExternalURL())
+	config.SidecarURL = types.StringValue(d.client.SidecarURL())
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
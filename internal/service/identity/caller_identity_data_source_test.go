@@ -0,0 +1,59 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package identity_test
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCallerIdentityDataSource_basic(t *testing.T) {
+	dataSourceName := "data.altr_caller_identity.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "altr" {
+  validate_credentials = true
+}
+
+data "altr_caller_identity" "test" {}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "org_id", os.Getenv("ALTR_ORG_ID")),
+					resource.TestCheckResourceAttr(dataSourceName, "api_key_id", os.Getenv("ALTR_API_KEY")),
+					resource.TestMatchResourceAttr(dataSourceName, "external_api_url", regexp.MustCompile(`^https://.*api.*/v1$`)),
+					resource.TestMatchResourceAttr(dataSourceName, "sidecar_api_url", regexp.MustCompile(`^https://.*sc-control.*/v1$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCallerIdentityDataSource_invalidCredentials(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "altr" {
+  secret               = "not-the-secret"
+  validate_credentials = true
+}
+
+data "altr_caller_identity" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid ALTR Credentials`),
+			},
+		},
+	})
+}
@@ -0,0 +1,45 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// BindingID builds the composite ID of a repo sidecar binding (sidecar_id:port:repo_name)
+func BindingID(sidecarID string, port int64, repoName string) string {
+	return fmt.Sprintf("%s:%d:%s", sidecarID, port, repoName)
+}
+
+// ParseBindingID splits a repo sidecar binding ID into its sidecar ID, port and repo name
+func ParseBindingID(id string) (string, int64, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 {
+		return "", 0, "", fmt.Errorf("expected binding ID in format sidecar_id:port:repo_name, got %q", id)
+	}
+
+	port, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", 0, "", fmt.Errorf("port must be a valid integer: %w", err)
+	}
+
+	return parts[0], port, parts[2], nil
+}
+
+// TaskID builds the composite ID of an agent task (agent_id:task_id)
+func TaskID(agentID, taskID string) string {
+	return agentID + ":" + taskID
+}
+
+// ParseTaskID splits an agent task ID into its agent ID and task ID
+func ParseTaskID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("expected task ID in format agent_id:task_id, got %q", id)
+	}
+
+	return parts[0], parts[1], nil
+}
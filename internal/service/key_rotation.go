@@ -0,0 +1,522 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/altrsoftware/terraform-provider-altr/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// KeyRotationConfirmedFingerprintRegex matches a public key fingerprint as exposed by the provider
+const KeyRotationConfirmedFingerprintRegex = `^[0-9a-f]{64}$`
+
+// KeyRotationModel holds the attributes of a key rotation resource. OwnerID is the sidecar_id
+// or agent_id attribute, whose name depends on the resource.
+type KeyRotationModel struct {
+	ID                   types.String   `tfsdk:"id"`
+	OwnerID              types.String   `tfsdk:"-"`
+	PublicKey            PublicKeyValue `tfsdk:"public_key"`
+	ConfirmedFingerprint types.String   `tfsdk:"confirmed_fingerprint"`
+	PublicKeyFingerprint types.String   `tfsdk:"public_key_fingerprint"`
+	ActiveSlot           types.Int64    `tfsdk:"active_slot"`
+	PreviousSlot         types.Int64    `tfsdk:"previous_slot"`
+	RegisteredAt         types.String   `tfsdk:"registered_at"`
+}
+
+var keyRotationAttrTypes = map[string]attr.Type{
+	"id":                     types.StringType,
+	"public_key":             PublicKeyType{},
+	"confirmed_fingerprint":  types.StringType,
+	"public_key_fingerprint": types.StringType,
+	"active_slot":            types.Int64Type,
+	"previous_slot":          types.Int64Type,
+	"registered_at":          types.StringType,
+}
+
+// PublicKeySlots are the two key slots of a sidecar or agent
+type PublicKeySlots struct {
+	ID   string
+	Key1 *client.PublicKey
+	Key2 *client.PublicKey
+}
+
+// PublicKeySlotClient reads and writes the key slots of a sidecar or agent
+type PublicKeySlotClient interface {
+	// GetPublicKeySlots returns nil when the sidecar or agent does not exist
+	GetPublicKeySlots(id string) (*PublicKeySlots, error)
+	// UpdatePublicKeySlot writes value into a single slot; an empty value clears the slot
+	UpdatePublicKeySlot(id string, slot int64, value string) (*PublicKeySlots, error)
+}
+
+var (
+	_ resource.Resource                = &KeyRotationResource{}
+	_ resource.ResourceWithImportState = &KeyRotationResource{}
+)
+
+// KeyRotationResource rotates the public key of a sidecar or agent through its two key slots
+type KeyRotationResource struct {
+	typeName string
+	noun     string
+	newSlots func(*client.Client) PublicKeySlotClient
+	slots    PublicKeySlotClient
+}
+
+// NewKeyRotationResource creates the altr_<typeName>_key_rotation resource. noun names the owner
+// in descriptions (e.g. "an agent") and newSlots adapts the configured client to its key slots.
+func NewKeyRotationResource(typeName, noun string, newSlots func(*client.Client) PublicKeySlotClient) resource.Resource {
+	return &KeyRotationResource{typeName: typeName, noun: noun, newSlots: newSlots}
+}
+
+func (r *KeyRotationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.typeName + "_key_rotation"
+}
+
+func (r *KeyRotationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotates the public key of " + r.noun + " without downtime. Changing public_key registers the new key in the " +
+			"slot that is not active, leaving the current key in place. Once the new private key is deployed to every " + r.typeName + " " +
+			"host, set confirmed_fingerprint to the new public_key_fingerprint to retire the previous key. Add public_key_1 and " +
+			"public_key_2 to ignore_changes on the altr_" + r.typeName + " resource when using this resource. Destroying this resource " +
+			"leaves the registered keys in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: strings.ToUpper(r.typeName[:1]) + r.typeName[1:] + " ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			r.ownerAttribute(): schema.StringAttribute{
+				Description: "ID of the " + r.typeName + " whose key is rotated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Description: "PEM-encoded PKIX RSA public key of at least 2048 bits to rotate to.",
+				CustomType:  PublicKeyType{},
+				Required:    true,
+				Validators: []validator.String{
+					validation.RSAPublicKey(),
+				},
+			},
+			"confirmed_fingerprint": schema.StringAttribute{
+				Description: "Fingerprint of the key confirmed as deployed. When it matches public_key_fingerprint the previous key is retired.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(KeyRotationConfirmedFingerprintRegex),
+						"must be a lowercase hex SHA-256 fingerprint",
+					),
+				},
+			},
+			"public_key_fingerprint": schema.StringAttribute{
+				Description: "SHA-256 fingerprint of public_key, as the lowercase hex digest of the DER-encoded key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					PublicKeyFingerprintFrom("public_key"),
+				},
+			},
+			"active_slot": schema.Int64Attribute{
+				Description: "Key slot (1 or 2) holding public_key.",
+				Computed:    true,
+			},
+			"previous_slot": schema.Int64Attribute{
+				Description: "Key slot holding the previous key until it is retired. Null once retired.",
+				Computed:    true,
+			},
+			"registered_at": schema.StringAttribute{
+				Description: "Time public_key was registered.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *KeyRotationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.slots = r.newSlots(client)
+}
+
+func (r *KeyRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan KeyRotationModel
+
+	resp.Diagnostics.Append(r.get(ctx, req.Plan.Get, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.rotate(&plan, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error rotating "+r.typeName+" key",
+			"Could not rotate key for "+r.typeName+" ID "+plan.OwnerID.ValueString()+": "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(r.set(ctx, &resp.State, plan)...)
+}
+
+func (r *KeyRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state KeyRotationModel
+
+	resp.Diagnostics.Append(r.get(ctx, req.State.Get, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slots, err := r.slots.GetPublicKeySlots(state.OwnerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading "+r.typeName+" key rotation",
+			"Could not read "+r.typeName+" ID "+state.OwnerID.ValueString()+": "+err.Error(),
+		)
+
+		return
+	}
+
+	if slots == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// Follow the key if it was moved to the other slot; otherwise report whatever is in the
+	// active slot so that drift shows up as a change to public_key
+	activeSlot := int64(0)
+	if !state.PublicKey.IsNull() {
+		activeSlot = PublicKeySlotOf(slots.Key1, slots.Key2, state.PublicKey.ValueString())
+	}
+
+	if activeSlot == 0 {
+		activeSlot = state.ActiveSlot.ValueInt64()
+	}
+
+	if activeSlot == 0 {
+		activeSlot = NewestPublicKeySlot(slots.Key1, slots.Key2)
+	}
+
+	if activeSlot == 0 {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	mapPublicKeySlotsToModel(slots, activeSlot, &state)
+
+	resp.Diagnostics.Append(r.set(ctx, &resp.State, state)...)
+}
+
+func (r *KeyRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state KeyRotationModel
+
+	resp.Diagnostics.Append(r.get(ctx, req.Plan.Get, &plan)...)
+	resp.Diagnostics.Append(r.get(ctx, req.State.Get, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.rotate(&plan, &state); err != nil {
+		resp.Diagnostics.AddError(
+			"Error rotating "+r.typeName+" key",
+			"Could not rotate key for "+r.typeName+" ID "+plan.OwnerID.ValueString()+": "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(r.set(ctx, &resp.State, plan)...)
+}
+
+// Delete only removes the rotation from state; the sidecar or agent keeps whatever keys are registered
+func (r *KeyRotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *KeyRotationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.ownerAttribute()), req.ID)...)
+}
+
+func (r *KeyRotationResource) ownerAttribute() string {
+	return r.typeName + "_id"
+}
+
+// get reads the plan or state into model; the owner attribute is split off first because its
+// name differs between resources
+func (r *KeyRotationResource) get(ctx context.Context, getter func(context.Context, any) diag.Diagnostics, model *KeyRotationModel) diag.Diagnostics {
+	var object types.Object
+
+	diags := getter(ctx, &object)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	attributes := maps.Clone(object.Attributes())
+	ownerID, _ := attributes[r.ownerAttribute()].(types.String)
+	delete(attributes, r.ownerAttribute())
+
+	shared, d := types.ObjectValue(keyRotationAttrTypes, attributes)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(shared.As(ctx, model, basetypes.ObjectAsOptions{})...)
+	model.OwnerID = ownerID
+
+	return diags
+}
+
+// set writes model to state, adding back the owner attribute
+func (r *KeyRotationResource) set(ctx context.Context, state *tfsdk.State, model KeyRotationModel) diag.Diagnostics {
+	shared, diags := types.ObjectValueFrom(ctx, keyRotationAttrTypes, model)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	attributes := maps.Clone(shared.Attributes())
+	attributes[r.ownerAttribute()] = model.OwnerID
+
+	attrTypes := maps.Clone(keyRotationAttrTypes)
+	attrTypes[r.ownerAttribute()] = types.StringType
+
+	object, d := types.ObjectValue(attrTypes, attributes)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(state.Set(ctx, object)...)
+
+	return diags
+}
+
+// rotate registers plan.PublicKey in the free slot and retires the previous key once the
+// new key's fingerprint has been confirmed. state is nil when the rotation is being created.
+func (r *KeyRotationResource) rotate(plan, state *KeyRotationModel) error {
+	id := plan.OwnerID.ValueString()
+
+	slots, err := r.slots.GetPublicKeySlots(id)
+	if err != nil {
+		return err
+	}
+
+	if slots == nil {
+		return fmt.Errorf("%s not found", r.typeName)
+	}
+
+	newKey := plan.PublicKey.ValueString()
+
+	activeSlot := int64(0)
+	activeConfirmed := false
+	if state != nil {
+		activeSlot = state.ActiveSlot.ValueInt64()
+		activeConfirmed = !state.PublicKeyFingerprint.IsNull() &&
+			(plan.ConfirmedFingerprint.Equal(state.PublicKeyFingerprint) || state.ConfirmedFingerprint.Equal(state.PublicKeyFingerprint))
+	}
+
+	slot, registered, err := PublicKeyRotationSlot(slots.Key1, slots.Key2, activeSlot, activeConfirmed, newKey)
+	if err != nil {
+		return err
+	}
+
+	if !registered {
+		slots, err = r.slots.UpdatePublicKeySlot(id, slot, newKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	previousSlot := OtherPublicKeySlot(slot)
+	fingerprint := PublicKeyFingerprintValue(plan.PublicKey)
+
+	if plan.ConfirmedFingerprint.Equal(fingerprint) && PublicKeyInSlot(slots.Key1, slots.Key2, previousSlot) != nil {
+		slots, err = r.slots.UpdatePublicKeySlot(id, previousSlot, "")
+		if err != nil {
+			return fmt.Errorf("registered new key in slot %d but could not retire previous key: %w", slot, err)
+		}
+	}
+
+	mapPublicKeySlotsToModel(slots, slot, plan)
+
+	return nil
+}
+
+// PublicKeySlotOf returns the slot (1 or 2) holding the given key, or 0 when neither slot does
+func PublicKeySlotOf(key1, key2 *client.PublicKey, value string) int64 {
+	if samePublicKey(key1, value) {
+		return 1
+	}
+
+	if samePublicKey(key2, value) {
+		return 2
+	}
+
+	return 0
+}
+
+// PublicKeyInSlot returns the key registered in the given slot, or nil when the slot is empty
+func PublicKeyInSlot(key1, key2 *client.PublicKey, slot int64) *client.PublicKey {
+	key := key1
+	if slot == 2 {
+		key = key2
+	}
+
+	if key == nil || key.RSAKey == "" {
+		return nil
+	}
+
+	return key
+}
+
+// OtherPublicKeySlot returns the slot that is not the given one
+func OtherPublicKeySlot(slot int64) int64 {
+	if slot == 1 {
+		return 2
+	}
+
+	return 1
+}
+
+// NewestPublicKeySlot returns the slot holding the most recently registered key, or 0 when both
+// slots are empty
+func NewestPublicKeySlot(key1, key2 *client.PublicKey) int64 {
+	k1 := PublicKeyInSlot(key1, key2, 1)
+	k2 := PublicKeyInSlot(key1, key2, 2)
+
+	switch {
+	case k1 == nil && k2 == nil:
+		return 0
+	case k2 == nil:
+		return 1
+	case k1 == nil:
+		return 2
+	}
+
+	t1, err1 := time.Parse(time.RFC3339, k1.RegisteredAt)
+	t2, err2 := time.Parse(time.RFC3339, k2.RegisteredAt)
+	if err1 == nil && err2 == nil {
+		if t2.After(t1) {
+			return 2
+		}
+
+		return 1
+	}
+
+	if k2.RegisteredAt > k1.RegisteredAt {
+		return 2
+	}
+
+	return 1
+}
+
+// PublicKeyRotationSlot decides which slot a rotation to newKey should write. A key that is already
+// registered stays where it is. Otherwise the key goes into the slot that is not currently active,
+// or on the first rotation into whichever slot is empty, so the active key keeps working until it
+// is retired. The slot that is not active is only overwritten when it is empty or when the active
+// key has been confirmed, since until then it holds the only key known to be deployed.
+func PublicKeyRotationSlot(key1, key2 *client.PublicKey, activeSlot int64, activeConfirmed bool, newKey string) (int64, bool, error) {
+	if slot := PublicKeySlotOf(key1, key2, newKey); slot != 0 {
+		return slot, true, nil
+	}
+
+	if activeSlot == 1 || activeSlot == 2 {
+		otherSlot := OtherPublicKeySlot(activeSlot)
+		if PublicKeyInSlot(key1, key2, otherSlot) != nil && !activeConfirmed {
+			return 0, false, fmt.Errorf("the key in slot %d has not been confirmed; set confirmed_fingerprint to its fingerprint to retire the key in slot %d before starting another rotation", activeSlot, otherSlot)
+		}
+
+		return otherSlot, false, nil
+	}
+
+	if PublicKeyInSlot(key1, key2, 1) == nil {
+		return 1, false, nil
+	}
+
+	if PublicKeyInSlot(key1, key2, 2) == nil {
+		return 2, false, nil
+	}
+
+	return 0, false, errors.New("both public key slots are in use; remove the key that is no longer deployed before starting a rotation")
+}
+
+func mapPublicKeySlotsToModel(slots *PublicKeySlots, activeSlot int64, model *KeyRotationModel) {
+	model.ID = types.StringValue(slots.ID)
+	model.OwnerID = types.StringValue(slots.ID)
+	model.ActiveSlot = types.Int64Value(activeSlot)
+
+	if key := PublicKeyInSlot(slots.Key1, slots.Key2, activeSlot); key != nil {
+		model.PublicKey = NewPublicKeyValue(key.RSAKey)
+		model.RegisteredAt = types.StringValue(key.RegisteredAt)
+	} else {
+		model.PublicKey = NewPublicKeyNull()
+		model.RegisteredAt = types.StringNull()
+	}
+
+	model.PublicKeyFingerprint = PublicKeyFingerprintValue(model.PublicKey)
+
+	previousSlot := OtherPublicKeySlot(activeSlot)
+	if PublicKeyInSlot(slots.Key1, slots.Key2, previousSlot) != nil {
+		model.PreviousSlot = types.Int64Value(previousSlot)
+	} else {
+		model.PreviousSlot = types.Int64Null()
+	}
+}
+
+func samePublicKey(key *client.PublicKey, value string) bool {
+	if key == nil || key.RSAKey == "" {
+		return false
+	}
+
+	_, existingDER, existingErr := validation.ParseRSAPublicKey(key.RSAKey)
+	_, newDER, newErr := validation.ParseRSAPublicKey(value)
+	if existingErr == nil && newErr == nil {
+		return bytes.Equal(existingDER, newDER)
+	}
+
+	return NormalizePublicKey(key.RSAKey) == NormalizePublicKey(value)
+}
@@ -0,0 +1,186 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package service_test
+
+import (
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+)
+
+func publicKey(value, registeredAt string) *client.PublicKey {
+	return &client.PublicKey{RSAKey: value, RegisteredAt: registeredAt}
+}
+
+func TestPublicKeySlotOf(t *testing.T) {
+	testCases := map[string]struct {
+		key1  *client.PublicKey
+		key2  *client.PublicKey
+		value string
+		want  int64
+	}{
+		"slot-1": {
+			key1:  publicKey("key-a", ""),
+			key2:  publicKey("key-b", ""),
+			value: "key-a",
+			want:  1,
+		},
+		"slot-2": {
+			key1:  publicKey("key-a", ""),
+			key2:  publicKey("key-b", ""),
+			value: "key-b",
+			want:  2,
+		},
+		"whitespace-differs": {
+			key1:  publicKey("key-a", ""),
+			value: "key-a\n",
+			want:  1,
+		},
+		"not-registered": {
+			key1:  publicKey("key-a", ""),
+			key2:  publicKey("key-b", ""),
+			value: "key-c",
+			want:  0,
+		},
+		"empty-slots": {
+			key1:  publicKey("", ""),
+			value: "",
+			want:  0,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := service.PublicKeySlotOf(tc.key1, tc.key2, tc.value); got != tc.want {
+				t.Fatalf("expected slot %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestNewestPublicKeySlot(t *testing.T) {
+	testCases := map[string]struct {
+		key1 *client.PublicKey
+		key2 *client.PublicKey
+		want int64
+	}{
+		"both-empty": {
+			want: 0,
+		},
+		"only-slot-1": {
+			key1: publicKey("key-a", "2024-01-01T00:00:00Z"),
+			want: 1,
+		},
+		"only-slot-2": {
+			key1: publicKey("", ""),
+			key2: publicKey("key-b", "2024-01-01T00:00:00Z"),
+			want: 2,
+		},
+		"slot-1-newer": {
+			key1: publicKey("key-a", "2024-06-01T00:00:00Z"),
+			key2: publicKey("key-b", "2024-01-01T00:00:00Z"),
+			want: 1,
+		},
+		"slot-2-newer": {
+			key1: publicKey("key-a", "2024-01-01T00:00:00Z"),
+			key2: publicKey("key-b", "2024-01-01T00:00:01+00:00"),
+			want: 2,
+		},
+		"unparseable-times": {
+			key1: publicKey("key-a", "a"),
+			key2: publicKey("key-b", "b"),
+			want: 2,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := service.NewestPublicKeySlot(tc.key1, tc.key2); got != tc.want {
+				t.Fatalf("expected slot %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestPublicKeyRotationSlot(t *testing.T) {
+	testCases := map[string]struct {
+		key1            *client.PublicKey
+		key2            *client.PublicKey
+		activeSlot      int64
+		activeConfirmed bool
+		newKey          string
+		wantSlot        int64
+		wantRegistered  bool
+		wantErr         bool
+	}{
+		"already-registered": {
+			key1:           publicKey("key-a", ""),
+			key2:           publicKey("key-b", ""),
+			activeSlot:     1,
+			newKey:         "key-b",
+			wantSlot:       2,
+			wantRegistered: true,
+		},
+		"first-rotation-slot-1-empty": {
+			key2:     publicKey("key-b", ""),
+			newKey:   "key-c",
+			wantSlot: 1,
+		},
+		"first-rotation-slot-2-empty": {
+			key1:     publicKey("key-a", ""),
+			newKey:   "key-c",
+			wantSlot: 2,
+		},
+		"first-rotation-both-in-use": {
+			key1:    publicKey("key-a", ""),
+			key2:    publicKey("key-b", ""),
+			newKey:  "key-c",
+			wantErr: true,
+		},
+		"other-slot-empty": {
+			key1:       publicKey("key-a", ""),
+			activeSlot: 1,
+			newKey:     "key-b",
+			wantSlot:   2,
+		},
+		"other-slot-retirable": {
+			key1:            publicKey("key-a", ""),
+			key2:            publicKey("key-b", ""),
+			activeSlot:      2,
+			activeConfirmed: true,
+			newKey:          "key-c",
+			wantSlot:        1,
+		},
+		"double-rotation-before-confirmation": {
+			key1:       publicKey("key-a", ""),
+			key2:       publicKey("key-b", ""),
+			activeSlot: 2,
+			newKey:     "key-c",
+			wantErr:    true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			slot, registered, err := service.PublicKeyRotationSlot(tc.key1, tc.key2, tc.activeSlot, tc.activeConfirmed, tc.newKey)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got slot %d", slot)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if slot != tc.wantSlot || registered != tc.wantRegistered {
+				t.Fatalf("expected slot %d (registered %t), got slot %d (registered %t)", tc.wantSlot, tc.wantRegistered, slot, registered)
+			}
+		})
+	}
+}
@@ -165,13 +165,13 @@ func (d *AccessManagementOLTPPolicyResource) Schema(ctx context.Context, req res
 				},
 			},
 			"database_type_name": schema.StringAttribute{
-				Description: "Database type name for the policy.",
+				Description: "Database type name for the policy: oracle, mssql, mysql, postgres, redshift or databricks.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					stringvalidator.OneOf("oracle", "mssql", "mysql", "postgres"),
+					stringvalidator.OneOf(OltpPolicyDatabaseTypeNames...),
 				},
 			},
 			"rules": schema.ListNestedAttribute{
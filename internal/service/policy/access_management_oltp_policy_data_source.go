@@ -8,12 +8,19 @@ import (
 	"fmt"
 
 	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var _ datasource.DataSource = &AccessManagementOLTPPolicyDataSource{}
+var (
+	_ datasource.DataSource                     = &AccessManagementOLTPPolicyDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &AccessManagementOLTPPolicyDataSource{}
+)
 
 func NewAccessManagementOLTPPolicyDataSource() datasource.DataSource {
 	return &AccessManagementOLTPPolicyDataSource{}
@@ -45,20 +52,29 @@ func (d *AccessManagementOLTPPolicyDataSource) Schema(ctx context.Context, req d
 		Description: "Data source for retrieving an OLTP access management policy.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Unique identifier for the OLTP access management policy.",
-				Required:    true,
+				Description: "Unique identifier for the OLTP access management policy. Exactly one of id or name must be specified.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"name": schema.StringAttribute{
-				Description: "Name of the OLTP access management policy.",
+				Description: "Name of the OLTP access management policy. Exactly one of id or name must be specified; the name must match exactly one OLTP access management policy, optionally narrowed by repo_name.",
+				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
 			"description": schema.StringAttribute{
 				Description: "Description of the OLTP access management policy.",
 				Computed:    true,
 			},
 			"repo_name": schema.StringAttribute{
-				Description: "The name of the repository this policy belongs to.",
+				Description: "The name of the repository this policy belongs to. Can only be set together with name, to tell apart policies with the same name on different repositories.",
+				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
 			"case_sensitivity": schema.StringAttribute{
 				Description: "Case sensitivity for the policy.",
@@ -193,6 +209,19 @@ func (d *AccessManagementOLTPPolicyDataSource) Schema(ctx context.Context, req d
 	}
 }
 
+func (d *AccessManagementOLTPPolicyDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("id"),
+			path.MatchRoot("repo_name"),
+		),
+	}
+}
+
 func (d *AccessManagementOLTPPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -218,6 +247,17 @@ func (d *AccessManagementOLTPPolicyDataSource) Read(ctx context.Context, req dat
 		return
 	}
 
+	// Resolve the name through the policy listing
+	if !config.Name.IsNull() {
+		id, diags := resolvePolicyID(d.client, client.PolicyTypeAccessManagementOLTP, config.Name.ValueString(), config.RepoName.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		config.ID = types.StringValue(id)
+	}
+
 	// Get the OLTP policy from the API
 	policy, err := d.client.GetAccessManagementOLTPPolicy(config.ID.ValueString())
 	if err != nil {
@@ -6,6 +6,9 @@ package policy
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/altrsoftware/terraform-provider-altr/internal/client"
 	customvalidation "github.com/altrsoftware/terraform-provider-altr/internal/validation"
@@ -17,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -24,8 +28,9 @@ import (
 )
 
 var (
-	_ resource.Resource                = &AccessManagementSnowflakePolicyResource{}
-	_ resource.ResourceWithImportState = &AccessManagementSnowflakePolicyResource{}
+	_ resource.Resource                   = &AccessManagementSnowflakePolicyResource{}
+	_ resource.ResourceWithImportState    = &AccessManagementSnowflakePolicyResource{}
+	_ resource.ResourceWithValidateConfig = &AccessManagementSnowflakePolicyResource{}
 )
 
 func NewAccessManagementSnowflakePolicyDataResource() resource.Resource {
@@ -165,25 +170,30 @@ func (d *AccessManagementSnowflakePolicyResource) Schema(ctx context.Context, re
 				Required: true,
 			},
 			"policy_maintenance": schema.SingleNestedAttribute{
-				Description: "Policy maintenance configuration.",
+				Description: "Policy maintenance configuration. Can be changed in place; removing it requires replacing the policy.",
 				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+							// The API keeps the existing schedule when policy_maintenance is omitted,
+							// so it can only be cleared by recreating the policy.
+							resp.RequiresReplace = !req.StateValue.IsNull() && req.PlanValue.IsNull()
+						},
+						"Removing policy maintenance requires replacing the policy.",
+						"Removing policy maintenance requires replacing the policy.",
+					),
+				},
 				Attributes: map[string]schema.Attribute{
 					"rate": schema.StringAttribute{
-						Description: "Rate at which the policy maintenance occurs.",
+						Description: "Rate at which the policy maintenance occurs: 'day' (every N days) or 'cron' (cron expression).",
 						Required:    true,
 						Validators: []validator.String{
-							stringvalidator.OneOf("day", "cron"),
-						},
-						PlanModifiers: []planmodifier.String{
-							stringplanmodifier.RequiresReplace(),
+							stringvalidator.OneOf(policyMaintenanceRateDay, policyMaintenanceRateCron),
 						},
 					},
 					"value": schema.StringAttribute{
-						Description: "Value for the policy maintenance rate.",
+						Description: "Value for the policy maintenance rate. A positive number of days when rate is 'day', or a 5-field cron expression (minute hour dom month dow) when rate is 'cron'.",
 						Required:    true,
-						PlanModifiers: []planmodifier.String{
-							stringplanmodifier.RequiresReplace(),
-						},
 					},
 				},
 			},
@@ -387,6 +397,26 @@ func (d *AccessManagementSnowflakePolicyResource) Schema(ctx context.Context, re
 	}
 }
 
+func (r *AccessManagementSnowflakePolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var rate, value types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("policy_maintenance").AtName("rate"), &rate)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("policy_maintenance").AtName("value"), &value)...)
+
+	if resp.Diagnostics.HasError() || rate.IsNull() || rate.IsUnknown() || value.IsNull() || value.IsUnknown() {
+		return
+	}
+
+	maintenance := &client.AccessManagementPolicyMaintenance{
+		Rate:  rate.ValueString(),
+		Value: value.ValueString(),
+	}
+
+	if err := validatePolicyMaintenance(maintenance); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("policy_maintenance").AtName("value"), "Invalid Configuration", err.Error())
+	}
+}
+
 func (r *AccessManagementSnowflakePolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -501,9 +531,10 @@ func (r *AccessManagementSnowflakePolicyResource) Update(ctx context.Context, re
 
 	// Create the input for the API call
 	input := client.UpdateAccessManagementSnowflakePolicyInput{
-		Name:        plan.Name.ValueString(),
-		Description: plan.Description.ValueString(),
-		Rules:       rules,
+		Name:              plan.Name.ValueString(),
+		Description:       plan.Description.ValueString(),
+		Rules:             rules,
+		PolicyMaintenance: plan.PolicyMaintenance,
 	}
 
 	// Call the API to update the access management snowflake policy
@@ -558,6 +589,47 @@ func (r *AccessManagementSnowflakePolicyResource) mapPolicyToModel(policy *clien
 	model.Rules = convertAccessManagementSnowflakeRulesToTerraform(policy)
 	model.CreatedAt = types.StringValue(policy.CreatedAt)
 	model.UpdatedAt = types.StringValue(policy.UpdatedAt)
+
+	// Not every response echoes the maintenance schedule; keep the configured value when it is absent
+	if policy.PolicyMaintenance != nil && policy.PolicyMaintenance.Rate != "" {
+		model.PolicyMaintenance = policy.PolicyMaintenance
+	}
+}
+
+const (
+	policyMaintenanceRateDay  = "day"
+	policyMaintenanceRateCron = "cron"
+)
+
+// cronFieldRegex matches a single cron field: numbers, names, ranges, steps, lists and wildcards
+var cronFieldRegex = regexp.MustCompile(`^(\*|\?|[0-9A-Za-z]+(-[0-9A-Za-z]+)?)(/[0-9]+)?(,(\*|[0-9A-Za-z]+(-[0-9A-Za-z]+)?)(/[0-9]+)?)*$`)
+
+// Helper function to validate the policy maintenance value against its rate
+func validatePolicyMaintenance(maintenance *client.AccessManagementPolicyMaintenance) error {
+	if maintenance == nil {
+		return nil
+	}
+
+	switch maintenance.Rate {
+	case policyMaintenanceRateDay:
+		days, err := strconv.Atoi(maintenance.Value)
+		if err != nil || days < 1 {
+			return fmt.Errorf("'value' must be a positive number of days when 'rate' is %q, got %q", policyMaintenanceRateDay, maintenance.Value)
+		}
+	case policyMaintenanceRateCron:
+		fields := strings.Fields(maintenance.Value)
+		if len(fields) != 5 {
+			return fmt.Errorf("'value' must be a cron expression with 5 fields (minute hour dom month dow) when 'rate' is %q, got %q", policyMaintenanceRateCron, maintenance.Value)
+		}
+
+		for _, field := range fields {
+			if !cronFieldRegex.MatchString(field) {
+				return fmt.Errorf("'value' contains an invalid cron field %q", field)
+			}
+		}
+	}
+
+	return nil
 }
 
 func convertAccessManagementSnowflakeRulesFromTerraform(rules types.List) []client.AccessManagementSnowflakeRule {
@@ -8,12 +8,19 @@ import (
 	"fmt"
 
 	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var _ datasource.DataSource = &AccessManagementSnowflakePolicyDataSource{}
+var (
+	_ datasource.DataSource                     = &AccessManagementSnowflakePolicyDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &AccessManagementSnowflakePolicyDataSource{}
+)
 
 func NewAccessManagementSnowflakePolicyDataSource() datasource.DataSource {
 	return &AccessManagementSnowflakePolicyDataSource{}
@@ -43,12 +50,17 @@ func (d *AccessManagementSnowflakePolicyDataSource) Schema(ctx context.Context,
 		Description: "Data source for retrieving a Snowflake access management policy.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Unique identifier for the Snowflake access management policy.",
-				Required:    true,
+				Description: "Unique identifier for the Snowflake access management policy. Exactly one of id or name must be specified.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"name": schema.StringAttribute{
-				Description: "Name of the Snowflake access management policy.",
+				Description: "Name of the Snowflake access management policy. Exactly one of id or name must be specified; the name must match exactly one Snowflake access management policy.",
+				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
 			"description": schema.StringAttribute{
 				Description: "Description of the Snowflake access management policy.",
@@ -213,6 +225,15 @@ func (d *AccessManagementSnowflakePolicyDataSource) Schema(ctx context.Context,
 	}
 }
 
+func (d *AccessManagementSnowflakePolicyDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d *AccessManagementSnowflakePolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -238,6 +259,17 @@ func (d *AccessManagementSnowflakePolicyDataSource) Read(ctx context.Context, re
 		return
 	}
 
+	// Resolve the name through the policy listing
+	if !config.Name.IsNull() {
+		id, diags := resolvePolicyID(d.client, client.PolicyTypeAccessManagementSnowflake, config.Name.ValueString(), "")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		config.ID = types.StringValue(id)
+	}
+
 	// Get access management snowflake policy from API
 	policy, err := d.client.GetAccessManagementSnowflakePolicy(config.ID.ValueString())
 	if err != nil {
@@ -5,6 +5,7 @@ package policy_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
@@ -69,6 +70,68 @@ func TestAccAccessManagementSnowflakePolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccAccessManagementSnowflakePolicy_policyMaintenance(t *testing.T) {
+	resourceName := "altr_access_management_snowflake_policy.test"
+	connectionID := 19 // Replace with a valid connection ID for testing
+
+	var policyID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAccessManagementSnowflakePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessManagementSnowflakePolicyConfigMaintenance(connectionID, "day", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy_maintenance.rate", "day"),
+					resource.TestCheckResourceAttr(resourceName, "policy_maintenance.value", "1"),
+					func(s *terraform.State) error {
+						policyID = s.RootModule().Resources[resourceName].Primary.ID
+
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccAccessManagementSnowflakePolicyConfigMaintenance(connectionID, "cron", "0 2 * * *"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy_maintenance.rate", "cron"),
+					resource.TestCheckResourceAttr(resourceName, "policy_maintenance.value", "0 2 * * *"),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources[resourceName].Primary.ID; id != policyID {
+							return fmt.Errorf("expected policy %s to be updated in place, but it was replaced by %s", policyID, id)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessManagementSnowflakePolicy_policyMaintenanceValidation(t *testing.T) {
+	connectionID := 19
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAccessManagementSnowflakePolicyConfigMaintenance(connectionID, "day", "0"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`positive number of days`),
+			},
+			{
+				Config:      testAccAccessManagementSnowflakePolicyConfigMaintenance(connectionID, "cron", "0 2 * *"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`cron expression with 5 fields`),
+			},
+		},
+	})
+}
+
 func testAccCheckAccessManagementSnowflakePolicyDestroy(s *terraform.State) error {
 	// Implement logic to verify the resource has been destroyed
 	return nil
@@ -133,3 +196,36 @@ resource "altr_access_management_snowflake_policy" "test" {
 }
 `, connectionID)
 }
+
+func testAccAccessManagementSnowflakePolicyConfigMaintenance(connectionID int, rate, value string) string {
+	return fmt.Sprintf(`
+resource "altr_access_management_snowflake_policy" "test" {
+  name        = "test-access-management-policy-maintenance"
+  description = "Test access management policy maintenance"
+  connection_ids = [%d]
+
+  policy_maintenance = {
+    rate  = %q
+    value = %q
+  }
+
+  rules = [
+    {
+      actors = [{
+        type        = "role"
+        identifiers = ["ACCOUNTADMIN"]
+        condition   = "equals"
+      }],
+      objects = [{
+        type        = "database"
+        identifiers = ["MY_DB"]
+        condition   = "equals"
+      }],
+      access = [{
+        name = "read"
+      }]
+    }
+  ]
+}
+`, connectionID, rate, value)
+}
@@ -3,6 +3,8 @@
 
 package policy
 
+import "github.com/altrsoftware/terraform-provider-altr/internal/client"
+
 var (
 	OltpActorTypes = []string{
 		"idp_user",
@@ -16,4 +18,29 @@ var (
 	OltpConditions = []string{
 		"equals",
 	}
+
+	// OltpPolicyDatabaseTypeNames are the repository types an OLTP access management
+	// policy can govern; Snowflake has its own policy type
+	OltpPolicyDatabaseTypeNames = []string{
+		"oracle",
+		"mssql",
+		"mysql",
+		"postgres",
+		"redshift",
+		"databricks",
+	}
 )
+
+// PolicyTypes are the policy types the unified policy listing reports
+var PolicyTypes = []string{
+	client.PolicyTypeImpersonation,
+	client.PolicyTypeAccessManagementOLTP,
+	client.PolicyTypeAccessManagementSnowflake,
+}
+
+// policyResourceTypes maps each policy type to the resource that manages it
+var policyResourceTypes = map[string]string{
+	client.PolicyTypeImpersonation:             "altr_impersonation_policy",
+	client.PolicyTypeAccessManagementOLTP:      "altr_access_management_oltp_policy",
+	client.PolicyTypeAccessManagementSnowflake: "altr_access_management_snowflake_policy",
+}
@@ -9,14 +9,19 @@ import (
 	"regexp"
 
 	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var _ datasource.DataSource = &ImpersonationPolicyDataSource{}
+var (
+	_ datasource.DataSource                     = &ImpersonationPolicyDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &ImpersonationPolicyDataSource{}
+)
 
 func NewImpersonationPolicyDataSource() datasource.DataSource {
 	return &ImpersonationPolicyDataSource{}
@@ -45,8 +50,9 @@ func (d *ImpersonationPolicyDataSource) Schema(ctx context.Context, req datasour
 		Description: "Data source for retrieving an impersonation policy.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Unique identifier for the impersonation policy.",
-				Required:    true,
+				Description: "Unique identifier for the impersonation policy. Exactly one of id or name must be specified.",
+				Optional:    true,
+				Computed:    true,
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(
 						regexp.MustCompile(`^[a-zA-Z0-9_#-]+$`),
@@ -55,16 +61,24 @@ func (d *ImpersonationPolicyDataSource) Schema(ctx context.Context, req datasour
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "Name of the impersonation policy.",
+				Description: "Name of the impersonation policy. Exactly one of id or name must be specified; the name must match exactly one impersonation policy, optionally narrowed by repo_name.",
+				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
 			"description": schema.StringAttribute{
 				Description: "Description of the impersonation policy.",
 				Computed:    true,
 			},
 			"repo_name": schema.StringAttribute{
-				Description: "The name of the repository where the impersonation policy is defined.",
+				Description: "The name of the repository where the impersonation policy is defined. Can only be set together with name, to tell apart policies with the same name on different repositories.",
+				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
 			"rules": schema.ListNestedAttribute{
 				Description: "List of rules for the impersonation policy.",
@@ -128,6 +142,19 @@ func (d *ImpersonationPolicyDataSource) Schema(ctx context.Context, req datasour
 	}
 }
 
+func (d *ImpersonationPolicyDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("id"),
+			path.MatchRoot("repo_name"),
+		),
+	}
+}
+
 func (d *ImpersonationPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -153,6 +180,17 @@ func (d *ImpersonationPolicyDataSource) Read(ctx context.Context, req datasource
 		return
 	}
 
+	// Resolve the name through the policy listing
+	if !config.Name.IsNull() {
+		id, diags := resolvePolicyID(d.client, client.PolicyTypeImpersonation, config.Name.ValueString(), config.RepoName.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		config.ID = types.StringValue(id)
+	}
+
 	// Get the impersonation policy from the API
 	policy, err := d.client.GetImpersonationPolicy(config.ID.ValueString())
 	if err != nil {
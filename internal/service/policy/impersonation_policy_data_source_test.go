@@ -5,6 +5,7 @@ package policy_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
@@ -45,6 +46,73 @@ func TestAccImpersonationPolicyDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccImpersonationPolicyDataSource_byName(t *testing.T) {
+	resourceName := "altr_impersonation_policy.test"
+
+	// Test data
+	policyName := acctest.RandomWithPrefixUnderscoreMaxLength("impersonation_policy", 32)
+	repoName := acctest.RandomWithPrefixUnderscoreMaxLength("repo", 32)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckImpersonationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImpersonationPolicyResourceConfig_basic(policyName, repoName) + `
+data "altr_impersonation_policy" "by_name" {
+  name = altr_impersonation_policy.test.name
+}
+
+data "altr_impersonation_policy" "by_name_and_repo" {
+  name      = altr_impersonation_policy.test.name
+  repo_name = altr_impersonation_policy.test.repo_name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.altr_impersonation_policy.by_name", "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair("data.altr_impersonation_policy.by_name", "repo_name", resourceName, "repo_name"),
+					resource.TestCheckResourceAttrPair("data.altr_impersonation_policy.by_name_and_repo", "id", resourceName, "id"),
+					resource.TestCheckResourceAttr("data.altr_impersonation_policy.by_name_and_repo", "rules.#", "1"),
+				),
+			},
+			{
+				Config: testAccImpersonationPolicyResourceConfig_basic(policyName, repoName) + `
+data "altr_impersonation_policy" "other_repo" {
+  name      = altr_impersonation_policy.test.name
+  repo_name = "not_${altr_impersonation_policy.test.repo_name}"
+}
+`,
+				ExpectError: regexp.MustCompile(`No impersonation policy named`),
+			},
+		},
+	})
+}
+
+func TestAccImpersonationPolicyDataSource_lookupValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "altr_impersonation_policy" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Exactly one of these attributes must be configured: \[id,name\]`),
+			},
+			{
+				Config: `
+data "altr_impersonation_policy" "test" {
+  id        = "example"
+  repo_name = "example"
+}
+`,
+				ExpectError: regexp.MustCompile(`These attributes cannot be configured together: \[id,repo_name\]`),
+			},
+		},
+	})
+}
+
 func testAccImpersonationPolicyResourceAndDataSourceConfig(policyName, repoName string) string {
 	return fmt.Sprintf(`
 resource "altr_impersonation_policy" "test" {
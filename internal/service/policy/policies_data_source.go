@@ -0,0 +1,244 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PoliciesDataSource{}
+
+func NewPoliciesDataSource() datasource.DataSource {
+	return &PoliciesDataSource{}
+}
+
+type PoliciesDataSource struct {
+	client *client.Client
+}
+
+type PoliciesDataSourceModel struct {
+	Type      types.String                    `tfsdk:"type"`
+	RepoName  types.String                    `tfsdk:"repo_name"`
+	NameRegex types.String                    `tfsdk:"name_regex"`
+	IDs       []types.String                  `tfsdk:"ids"`
+	Policies  []PoliciesDataSourcePolicyModel `tfsdk:"policies"`
+}
+
+type PoliciesDataSourcePolicyModel struct {
+	ID            types.String  `tfsdk:"id"`
+	Name          types.String  `tfsdk:"name"`
+	Description   types.String  `tfsdk:"description"`
+	Type          types.String  `tfsdk:"type"`
+	ResourceType  types.String  `tfsdk:"resource_type"`
+	RepoName      types.String  `tfsdk:"repo_name"`
+	ConnectionIds []types.Int64 `tfsdk:"connection_ids"`
+	CreatedAt     types.String  `tfsdk:"created_at"`
+	UpdatedAt     types.String  `tfsdk:"updated_at"`
+}
+
+func (d *PoliciesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policies"
+}
+
+func (d *PoliciesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for listing the impersonation, OLTP access management and Snowflake access management policies in the organization, " +
+			"optionally filtered. All filters that are set must match. Rules are not returned; use the data source for the policy type to read them.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "Only return policies of this type: impersonation, access_management_oltp or access_management_snowflake.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(PolicyTypes...),
+				},
+			},
+			"repo_name": schema.StringAttribute{
+				Description: "Only return policies defined on this repository. Snowflake access management policies are never matched.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return policies whose name matches this RE2 regular expression.",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "IDs of the matching policies, in the same order as policies.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"policies": schema.ListNestedAttribute{
+				Description: "Matching policies, sorted by name and ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Unique identifier for the policy.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the policy.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the policy.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the policy: impersonation, access_management_oltp or access_management_snowflake.",
+							Computed:    true,
+						},
+						"resource_type": schema.StringAttribute{
+							Description: "Terraform resource type that manages policies of this type, for use in import blocks. Null for types this provider does not manage.",
+							Computed:    true,
+						},
+						"repo_name": schema.StringAttribute{
+							Description: "Name of the repository the policy is defined on. Null for Snowflake access management policies.",
+							Computed:    true,
+						},
+						"connection_ids": schema.ListAttribute{
+							Description: "Snowflake connection IDs the policy applies to. Null for other policy types.",
+							ElementType: types.Int64Type,
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Creation timestamp.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "Last update timestamp.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PoliciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config PoliciesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !config.NameRegex.IsNull() {
+		var err error
+
+		nameRegex, err = regexp.Compile(config.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Configuration",
+				"name_regex is not a valid regular expression: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Get policies of all types from the API
+	policies, err := d.client.ListPolicies()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing policies",
+			"Could not list policies, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	sort.Slice(policies, func(i, j int) bool {
+		if policies[i].Name != policies[j].Name {
+			return policies[i].Name < policies[j].Name
+		}
+
+		return policies[i].ID < policies[j].ID
+	})
+
+	config.IDs = []types.String{}
+	config.Policies = []PoliciesDataSourcePolicyModel{}
+
+	for _, policy := range policies {
+		if !config.Type.IsNull() && policy.Type != config.Type.ValueString() {
+			continue
+		}
+
+		if !config.RepoName.IsNull() && policy.RepoName != config.RepoName.ValueString() {
+			continue
+		}
+
+		if nameRegex != nil && !nameRegex.MatchString(policy.Name) {
+			continue
+		}
+
+		config.IDs = append(config.IDs, types.StringValue(policy.ID))
+		config.Policies = append(config.Policies, d.mapPolicyToModel(policy))
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
+
+// Helper function to map API response to Terraform model
+func (d *PoliciesDataSource) mapPolicyToModel(policy client.PolicySummary) PoliciesDataSourcePolicyModel {
+	model := PoliciesDataSourcePolicyModel{
+		ID:           types.StringValue(policy.ID),
+		Name:         types.StringValue(policy.Name),
+		Description:  types.StringValue(policy.Description),
+		Type:         types.StringValue(policy.Type),
+		ResourceType: types.StringNull(),
+		RepoName:     types.StringNull(),
+		CreatedAt:    types.StringValue(policy.CreatedAt),
+		UpdatedAt:    types.StringValue(policy.UpdatedAt),
+	}
+
+	if resourceType, ok := policyResourceTypes[policy.Type]; ok {
+		model.ResourceType = types.StringValue(resourceType)
+	}
+
+	if policy.RepoName != "" {
+		model.RepoName = types.StringValue(policy.RepoName)
+	}
+
+	if policy.Type == client.PolicyTypeAccessManagementSnowflake {
+		model.ConnectionIds = []types.Int64{}
+		for _, id := range policy.ConnectionIds {
+			model.ConnectionIds = append(model.ConnectionIds, types.Int64Value(id))
+		}
+	}
+
+	return model
+}
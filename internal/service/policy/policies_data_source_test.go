@@ -0,0 +1,70 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package policy_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPoliciesDataSource_basic(t *testing.T) {
+	resourceName := "altr_impersonation_policy.test"
+	dataSourceName := "data.altr_policies.test"
+
+	// Test data
+	policyName := acctest.RandomWithPrefixUnderscoreMaxLength("impersonation_policy", 32)
+	repoName := acctest.RandomWithPrefixUnderscoreMaxLength("repo", 32)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckImpersonationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImpersonationPolicyResourceConfig_basic(policyName, repoName) + `
+data "altr_policies" "test" {
+  type       = "impersonation"
+  repo_name  = altr_impersonation_policy.test.repo_name
+  name_regex = "^${altr_impersonation_policy.test.name}$"
+}
+
+data "altr_policies" "other_type" {
+  type      = "access_management_oltp"
+  repo_name = altr_impersonation_policy.test.repo_name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "policies.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policies.0.name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policies.0.repo_name", resourceName, "repo_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.type", "impersonation"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.resource_type", "altr_impersonation_policy"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "policies.0.connection_ids"),
+					resource.TestCheckResourceAttr("data.altr_policies.other_type", "policies.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPoliciesDataSource_invalidType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "altr_policies" "test" {
+  type = "masking"
+}
+`,
+				ExpectError: regexp.MustCompile(`Attribute type value must be one of`),
+			},
+		},
+	})
+}
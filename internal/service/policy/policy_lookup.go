@@ -0,0 +1,80 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// policyTypeLabels are the human-readable policy type names used in lookup errors
+var policyTypeLabels = map[string]string{
+	client.PolicyTypeImpersonation:             "impersonation policy",
+	client.PolicyTypeAccessManagementOLTP:      "OLTP access management policy",
+	client.PolicyTypeAccessManagementSnowflake: "Snowflake access management policy",
+}
+
+// resolvePolicyID returns the ID of the only policy of the given type with the
+// given name. An empty repoName matches policies on any repository.
+func resolvePolicyID(c *client.Client, policyType, name, repoName string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	label := policyTypeLabels[policyType]
+
+	policies, err := c.ListPolicies()
+	if err != nil {
+		diags.AddError(
+			"Error reading "+label,
+			"Could not list policies, unexpected error: "+err.Error(),
+		)
+
+		return "", diags
+	}
+
+	var matches []string
+	for _, policy := range policies {
+		if policy.Type != policyType || policy.Name != name {
+			continue
+		}
+
+		if repoName != "" && policy.RepoName != repoName {
+			continue
+		}
+
+		matches = append(matches, policy.ID)
+	}
+
+	scope := ""
+	if repoName != "" {
+		scope = fmt.Sprintf(" on repository '%s'", repoName)
+	}
+
+	if len(matches) == 0 {
+		diags.AddError(
+			"Policy not found",
+			fmt.Sprintf("No %s named '%s' exists%s.", label, name, scope),
+		)
+
+		return "", diags
+	}
+
+	if len(matches) > 1 {
+		hint := "Look the policy up by id instead."
+		if repoName == "" && policyType != client.PolicyTypeAccessManagementSnowflake {
+			hint = "Set repo_name or look the policy up by id instead."
+		}
+
+		diags.AddError(
+			"Multiple policies found",
+			fmt.Sprintf("Found %d %s entries named '%s'%s (IDs: %s). %s", len(matches), label, name, scope, strings.Join(matches, ", "), hint),
+		)
+
+		return "", diags
+	}
+
+	return matches[0], diags
+}
@@ -0,0 +1,180 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = PublicKeyType{}
+	_ basetypes.StringValuableWithSemanticEquals = PublicKeyValue{}
+)
+
+// PublicKeyType is a string type for PEM-encoded public keys that treats keys differing
+// only in whitespace or line wrapping as equal
+type PublicKeyType struct {
+	basetypes.StringType
+}
+
+func (t PublicKeyType) Equal(o attr.Type) bool {
+	other, ok := o.(PublicKeyType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t PublicKeyType) String() string {
+	return "PublicKeyType"
+}
+
+func (t PublicKeyType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return PublicKeyValue{StringValue: in}, nil
+}
+
+func (t PublicKeyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return PublicKeyValue{StringValue: stringValue}, nil
+}
+
+func (t PublicKeyType) ValueType(ctx context.Context) attr.Value {
+	return PublicKeyValue{}
+}
+
+// PublicKeyValue is the value of a PublicKeyType attribute
+type PublicKeyValue struct {
+	basetypes.StringValue
+}
+
+func (v PublicKeyValue) Equal(o attr.Value) bool {
+	other, ok := o.(PublicKeyValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v PublicKeyValue) Type(ctx context.Context) attr.Type {
+	return PublicKeyType{}
+}
+
+// StringSemanticEquals compares the decoded keys, falling back to a whitespace-insensitive
+// comparison when either value does not parse
+func (v PublicKeyValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(PublicKeyValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this to the provider developers.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	_, oldDER, oldErr := validation.ParseRSAPublicKey(v.ValueString())
+	_, newDER, newErr := validation.ParseRSAPublicKey(newValue.ValueString())
+	if oldErr == nil && newErr == nil {
+		return bytes.Equal(oldDER, newDER), diags
+	}
+
+	return NormalizePublicKey(v.ValueString()) == NormalizePublicKey(newValue.ValueString()), diags
+}
+
+// NewPublicKeyValue returns a known PublicKeyValue
+func NewPublicKeyValue(value string) PublicKeyValue {
+	return PublicKeyValue{StringValue: types.StringValue(value)}
+}
+
+// NewPublicKeyNull returns a null PublicKeyValue
+func NewPublicKeyNull() PublicKeyValue {
+	return PublicKeyValue{StringValue: types.StringNull()}
+}
+
+// NormalizePublicKey removes all whitespace so that re-wrapped keys compare equal
+func NormalizePublicKey(value string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+
+		return r
+	}, value)
+}
+
+// PublicKeyFingerprintValue returns the fingerprint of a public key, or null when the key is
+// not set or cannot be parsed
+func PublicKeyFingerprintValue(key PublicKeyValue) types.String {
+	if key.IsNull() || key.IsUnknown() {
+		return types.StringNull()
+	}
+
+	fingerprint, err := validation.PublicKeyFingerprint(key.ValueString())
+	if err != nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(fingerprint)
+}
+
+// PublicKeyFingerprintFrom returns a plan modifier that computes a fingerprint attribute
+// from the planned value of the sibling key attribute
+func PublicKeyFingerprintFrom(keyAttribute string) planmodifier.String {
+	return publicKeyFingerprintModifier{keyAttribute: keyAttribute}
+}
+
+type publicKeyFingerprintModifier struct {
+	keyAttribute string
+}
+
+func (m publicKeyFingerprintModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("Computes the fingerprint from the planned value of %s.", m.keyAttribute)
+}
+
+func (m publicKeyFingerprintModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m publicKeyFingerprintModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to compute on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var key PublicKeyValue
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(m.keyAttribute), &key)...)
+
+	if resp.Diagnostics.HasError() || key.IsUnknown() {
+		return
+	}
+
+	resp.PlanValue = PublicKeyFingerprintValue(key)
+}
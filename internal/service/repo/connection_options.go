@@ -0,0 +1,247 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Connection options hold the settings sidecars and agents need to connect to a
+// repository beyond its hostname and port. Each repository type has its own
+// block inside connection_options, and only the block of the repository type
+// may be set.
+
+// Connection option block names, matching the attribute names inside connection_options
+const (
+	connectionOptionsOracle   = "oracle"
+	connectionOptionsPostgres = "postgres"
+	connectionOptionsMSSQL    = "mssql"
+	connectionOptionsMySQL    = "mysql"
+)
+
+// connectionOptionsRepoTypes maps each connection option block to the repository
+// types that accept it; Redshift speaks the Postgres protocol
+var connectionOptionsRepoTypes = map[string][]string{
+	connectionOptionsOracle:   {"Oracle"},
+	connectionOptionsPostgres: {"Postgres", "Redshift"},
+	connectionOptionsMSSQL:    {"MSSQL"},
+	connectionOptionsMySQL:    {"MySQL"},
+}
+
+var postgresSSLModes = []string{
+	"disable",
+	"allow",
+	"prefer",
+	"require",
+	"verify-ca",
+	"verify-full",
+}
+
+var mysqlTLSModes = []string{
+	"DISABLED",
+	"PREFERRED",
+	"REQUIRED",
+	"VERIFY_CA",
+	"VERIFY_IDENTITY",
+}
+
+var oracleOptionsAttrTypes = map[string]attr.Type{
+	"service_name": types.StringType,
+	"sid":          types.StringType,
+}
+
+var postgresOptionsAttrTypes = map[string]attr.Type{
+	"database": types.StringType,
+	"sslmode":  types.StringType,
+}
+
+var mssqlOptionsAttrTypes = map[string]attr.Type{
+	"instance_name": types.StringType,
+	"encrypt":       types.BoolType,
+}
+
+var mysqlOptionsAttrTypes = map[string]attr.Type{
+	"tls_mode": types.StringType,
+}
+
+var connectionOptionsAttrTypes = map[string]attr.Type{
+	connectionOptionsOracle:   types.ObjectType{AttrTypes: oracleOptionsAttrTypes},
+	connectionOptionsPostgres: types.ObjectType{AttrTypes: postgresOptionsAttrTypes},
+	connectionOptionsMSSQL:    types.ObjectType{AttrTypes: mssqlOptionsAttrTypes},
+	connectionOptionsMySQL:    types.ObjectType{AttrTypes: mysqlOptionsAttrTypes},
+}
+
+func connectionOptionsSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Type-specific connection settings used by sidecars and agents. Only the block matching type may be set: " +
+			"oracle for Oracle, postgres for Postgres and Redshift, mssql for MSSQL and mysql for MySQL.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			connectionOptionsOracle: schema.SingleNestedAttribute{
+				Description: "Oracle connection settings. Exactly one of service_name or sid must be set.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"service_name": schema.StringAttribute{
+						Description: "Oracle service name to connect to.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 128),
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("sid")),
+						},
+					},
+					"sid": schema.StringAttribute{
+						Description: "Oracle system identifier (SID) to connect to.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 64),
+						},
+					},
+				},
+			},
+			connectionOptionsPostgres: schema.SingleNestedAttribute{
+				Description: "Postgres and Redshift connection settings.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"database": schema.StringAttribute{
+						Description: "Database to connect to when a client does not name one.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 63),
+						},
+					},
+					"sslmode": schema.StringAttribute{
+						Description: "libpq sslmode: disable, allow, prefer, require, verify-ca or verify-full.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(postgresSSLModes...),
+						},
+					},
+				},
+			},
+			connectionOptionsMSSQL: schema.SingleNestedAttribute{
+				Description: "MSSQL connection settings.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"instance_name": schema.StringAttribute{
+						Description: "Named instance to connect to.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 128),
+						},
+					},
+					"encrypt": schema.BoolAttribute{
+						Description: "Whether connections to the repository are encrypted.",
+						Optional:    true,
+					},
+				},
+			},
+			connectionOptionsMySQL: schema.SingleNestedAttribute{
+				Description: "MySQL connection settings.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"tls_mode": schema.StringAttribute{
+						Description: "TLS mode: DISABLED, PREFERRED, REQUIRED, VERIFY_CA or VERIFY_IDENTITY.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(mysqlTLSModes...),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// connectionOptionsFromObject converts the connection_options object into the
+// client-side representation, or nil when it is not set.
+func connectionOptionsFromObject(obj basetypes.ObjectValue) *client.RepoConnectionOptions {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+
+	options := &client.RepoConnectionOptions{}
+	attrs := obj.Attributes()
+
+	if oracle, ok := attrs[connectionOptionsOracle].(basetypes.ObjectValue); ok && !oracle.IsNull() {
+		options.Oracle = &client.OracleConnectionOptions{
+			ServiceName: oracle.Attributes()["service_name"].(types.String).ValueString(),
+			SID:         oracle.Attributes()["sid"].(types.String).ValueString(),
+		}
+	}
+
+	if postgres, ok := attrs[connectionOptionsPostgres].(basetypes.ObjectValue); ok && !postgres.IsNull() {
+		options.Postgres = &client.PostgresConnectionOptions{
+			Database: postgres.Attributes()["database"].(types.String).ValueString(),
+			SSLMode:  postgres.Attributes()["sslmode"].(types.String).ValueString(),
+		}
+	}
+
+	if mssql, ok := attrs[connectionOptionsMSSQL].(basetypes.ObjectValue); ok && !mssql.IsNull() {
+		options.MSSQL = &client.MSSQLConnectionOptions{
+			InstanceName: mssql.Attributes()["instance_name"].(types.String).ValueString(),
+			Encrypt:      mssql.Attributes()["encrypt"].(types.Bool).ValueBoolPointer(),
+		}
+	}
+
+	if mysql, ok := attrs[connectionOptionsMySQL].(basetypes.ObjectValue); ok && !mysql.IsNull() {
+		options.MySQL = &client.MySQLConnectionOptions{
+			TLSMode: mysql.Attributes()["tls_mode"].(types.String).ValueString(),
+		}
+	}
+
+	return options
+}
+
+// connectionOptionsToObject converts the connection options of the API response
+// back into the connection_options object, nulling out absent blocks and settings.
+func connectionOptionsToObject(options *client.RepoConnectionOptions) basetypes.ObjectValue {
+	if options == nil || (options.Oracle == nil && options.Postgres == nil && options.MSSQL == nil && options.MySQL == nil) {
+		return basetypes.NewObjectNull(connectionOptionsAttrTypes)
+	}
+
+	oracle := basetypes.NewObjectNull(oracleOptionsAttrTypes)
+	if options.Oracle != nil {
+		oracle = basetypes.NewObjectValueMust(oracleOptionsAttrTypes, map[string]attr.Value{
+			"service_name": optionalStringValue(options.Oracle.ServiceName),
+			"sid":          optionalStringValue(options.Oracle.SID),
+		})
+	}
+
+	postgres := basetypes.NewObjectNull(postgresOptionsAttrTypes)
+	if options.Postgres != nil {
+		postgres = basetypes.NewObjectValueMust(postgresOptionsAttrTypes, map[string]attr.Value{
+			"database": optionalStringValue(options.Postgres.Database),
+			"sslmode":  optionalStringValue(options.Postgres.SSLMode),
+		})
+	}
+
+	mssql := basetypes.NewObjectNull(mssqlOptionsAttrTypes)
+	if options.MSSQL != nil {
+		mssql = basetypes.NewObjectValueMust(mssqlOptionsAttrTypes, map[string]attr.Value{
+			"instance_name": optionalStringValue(options.MSSQL.InstanceName),
+			"encrypt":       types.BoolPointerValue(options.MSSQL.Encrypt),
+		})
+	}
+
+	mysql := basetypes.NewObjectNull(mysqlOptionsAttrTypes)
+	if options.MySQL != nil {
+		mysql = basetypes.NewObjectValueMust(mysqlOptionsAttrTypes, map[string]attr.Value{
+			"tls_mode": optionalStringValue(options.MySQL.TLSMode),
+		})
+	}
+
+	return basetypes.NewObjectValueMust(connectionOptionsAttrTypes, map[string]attr.Value{
+		connectionOptionsOracle:   oracle,
+		connectionOptionsPostgres: postgres,
+		connectionOptionsMSSQL:    mssql,
+		connectionOptionsMySQL:    mysql,
+	})
+}
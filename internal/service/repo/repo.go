@@ -7,26 +7,44 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/altrsoftware/terraform-provider-altr/internal/client"
 	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/altrsoftware/terraform-provider-altr/internal/validation"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 var (
-	_ resource.Resource                = &RepoResource{}
-	_ resource.ResourceWithImportState = &RepoResource{}
+	_ resource.Resource                   = &RepoResource{}
+	_ resource.ResourceWithImportState    = &RepoResource{}
+	_ resource.ResourceWithValidateConfig = &RepoResource{}
 )
 
+// repoTypeAttributes maps each type-specific connection attribute to the repository
+// types that accept it
+var repoTypeAttributes = map[string][]string{
+	"account_locator": {service.DatabaseTypeSnowflake},
+	"warehouse":       {service.DatabaseTypeSnowflake},
+	"http_path":       {service.DatabaseTypeDatabricks},
+}
+
+// requiredRepoTypeAttributes lists the connection attributes a repository type cannot do without
+var requiredRepoTypeAttributes = map[string][]string{
+	service.DatabaseTypeSnowflake:  {"account_locator"},
+	service.DatabaseTypeDatabricks: {"http_path"},
+}
+
 func NewRepoResource() resource.Resource {
 	return &RepoResource{}
 }
@@ -36,16 +54,20 @@ type RepoResource struct {
 }
 
 type RepoResourceModel struct {
-	Name             types.String `tfsdk:"name"`
-	Description      types.String `tfsdk:"description"`
-	Type             types.String `tfsdk:"type"`
-	Hostname         types.String `tfsdk:"hostname"`
-	Port             types.Int64  `tfsdk:"port"`
-	UserCount        types.Int64  `tfsdk:"user_count"`
-	ServiceUserCount types.Int64  `tfsdk:"service_user_count"`
-	BindingCount     types.Int64  `tfsdk:"binding_count"`
-	CreatedAt        types.String `tfsdk:"created_at"`
-	UpdatedAt        types.String `tfsdk:"updated_at"`
+	Name              types.String          `tfsdk:"name"`
+	Description       types.String          `tfsdk:"description"`
+	Type              types.String          `tfsdk:"type"`
+	Hostname          service.HostValue     `tfsdk:"hostname"`
+	Port              types.Int64           `tfsdk:"port"`
+	AccountLocator    types.String          `tfsdk:"account_locator"`
+	Warehouse         types.String          `tfsdk:"warehouse"`
+	HTTPPath          types.String          `tfsdk:"http_path"`
+	ConnectionOptions basetypes.ObjectValue `tfsdk:"connection_options"`
+	UserCount         types.Int64           `tfsdk:"user_count"`
+	ServiceUserCount  types.Int64           `tfsdk:"service_user_count"`
+	BindingCount      types.Int64           `tfsdk:"binding_count"`
+	CreatedAt         types.String          `tfsdk:"created_at"`
+	UpdatedAt         types.String          `tfsdk:"updated_at"`
 }
 
 func (r *RepoResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -79,39 +101,59 @@ func (r *RepoResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				},
 			},
 			"type": schema.StringAttribute{
-				Description: "Type of the repository (e.g., Oracle).",
+				Description: "Type of the repository: Oracle, MSSQL, MySQL, Postgres, Snowflake, Redshift or Databricks.",
 				Required:    true,
 				Validators: []validator.String{
-					stringvalidator.OneOf(service.OltpDatabaseTypes...),
+					stringvalidator.OneOf(service.DatabaseTypes...),
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"hostname": schema.StringAttribute{
-				Description: "Hostname of the repository.",
-				Required:    true,
+				Description: "Hostname or IP address of the repository. Accepts RFC 1123 hostnames, IPv4 addresses and IPv6 addresses with or without brackets; " +
+					"differences in hostname case, a trailing dot or the IP address spelling are ignored. Can be changed in place, e.g. to fail over to a replica.",
+				CustomType: service.HostType{},
+				Required:   true,
 				Validators: []validator.String{
-					stringvalidator.LengthBetween(1, 500),
-					stringvalidator.RegexMatches(
-						regexp.MustCompile(service.HostnameRegexStringRFC1123),
-						"must be a valid hostname",
-					),
-				},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					validation.Host(),
 				},
 			},
 			"port": schema.Int64Attribute{
-				Description: "Port number of the repository.",
-				Required:    true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
+				Description: "Port number of the repository. Defaults to the well-known port of the type " +
+					"(Oracle 1521, MSSQL 1433, MySQL 3306, Postgres 5432, Snowflake 443, Redshift 5439, Databricks 443). Can be changed in place.",
+				Optional: true,
+				Computed: true,
 				Validators: []validator.Int64{
 					int64validator.Between(1, 65535),
 				},
+				PlanModifiers: []planmodifier.Int64{
+					service.DefaultPortForType("type"),
+				},
+			},
+			"account_locator": schema.StringAttribute{
+				Description: "Snowflake account locator (e.g. xy12345.us-east-1). Required when type is Snowflake and may not be set otherwise.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"warehouse": schema.StringAttribute{
+				Description: "Snowflake virtual warehouse used for queries. May only be set when type is Snowflake.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"http_path": schema.StringAttribute{
+				Description: "HTTP path of the Databricks SQL warehouse (e.g. /sql/1.0/warehouses/abc123). Required when type is Databricks and may not be set otherwise.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/`), "must start with /"),
+				},
 			},
+			"connection_options": connectionOptionsSchemaAttribute(),
 			"user_count": schema.Int64Attribute{
 				Description: "Number of users associated with this repository.",
 				Computed:    true,
@@ -139,6 +181,88 @@ func (r *RepoResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 	}
 }
 
+func (r *RepoResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var repoType types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &repoType)...)
+
+	if resp.Diagnostics.HasError() || repoType.IsUnknown() || repoType.IsNull() {
+		return
+	}
+
+	for attribute, repoTypes := range repoTypeAttributes {
+		var value types.String
+
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute), &value)...)
+
+		if value.IsNull() || slices.Contains(repoTypes, repoType.ValueString()) {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("%s may only be set when type is %s, got type %s.", attribute, strings.Join(repoTypes, " or "), repoType.ValueString()),
+		)
+	}
+
+	var connectionOptions basetypes.ObjectValue
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("connection_options"), &connectionOptions)...)
+
+	if !connectionOptions.IsNull() && !connectionOptions.IsUnknown() {
+		r.validateConnectionOptions(repoType.ValueString(), connectionOptions, resp)
+	}
+
+	for _, attribute := range requiredRepoTypeAttributes[repoType.ValueString()] {
+		var value types.String
+
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute), &value)...)
+
+		if !value.IsNull() {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Missing Attribute Configuration",
+			fmt.Sprintf("%s is required when type is %s.", attribute, repoType.ValueString()),
+		)
+	}
+}
+
+// validateConnectionOptions ensures connection_options sets exactly one block, and that
+// the block belongs to the repository type
+func (r *RepoResource) validateConnectionOptions(repoType string, connectionOptions basetypes.ObjectValue, resp *resource.ValidateConfigResponse) {
+	configured := 0
+
+	for block, value := range connectionOptions.Attributes() {
+		if value.IsNull() {
+			continue
+		}
+
+		configured++
+
+		if slices.Contains(connectionOptionsRepoTypes[block], repoType) {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("connection_options").AtName(block),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("connection_options.%s may only be set when type is %s, got type %s.", block, strings.Join(connectionOptionsRepoTypes[block], " or "), repoType),
+		)
+	}
+
+	if configured == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("connection_options"),
+			"Missing Attribute Configuration",
+			"connection_options must set the block of the repository type, or be omitted.",
+		)
+	}
+}
+
 func (r *RepoResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -166,13 +290,22 @@ func (r *RepoResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	// The port defaults from the type, which may only have become known during apply
+	if plan.Port.IsUnknown() {
+		plan.Port = types.Int64Value(service.DefaultDatabasePorts[plan.Type.ValueString()])
+	}
+
 	// Create the input for the API call
 	input := client.CreateRepoInput{
-		Name:        plan.Name.ValueString(),
-		Type:        plan.Type.ValueString(),
-		Hostname:    plan.Hostname.ValueString(),
-		Port:        int(plan.Port.ValueInt64()),
-		Description: plan.Description.ValueString(),
+		Name:              plan.Name.ValueString(),
+		Type:              plan.Type.ValueString(),
+		Hostname:          plan.Hostname.NormalizedHost(),
+		Port:              int(plan.Port.ValueInt64()),
+		Description:       plan.Description.ValueString(),
+		AccountLocator:    plan.AccountLocator.ValueString(),
+		Warehouse:         plan.Warehouse.ValueString(),
+		HTTPPath:          plan.HTTPPath.ValueString(),
+		ConnectionOptions: connectionOptionsFromObject(plan.ConnectionOptions),
 	}
 
 	// Call the API to create the repo
@@ -243,9 +376,45 @@ func (r *RepoResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Create the input for the API call
 	input := client.UpdateRepoInput{}
 
-	// Only description can be updated according to the API spec
-	if !plan.Description.Equal(state.Description) {
-		input.Description = plan.Description.ValueString()
+	// Only send the fields that changed; the repo keeps its name, so users,
+	// bindings and policies that reference it are left intact
+	if !plan.Description.IsUnknown() && !plan.Description.Equal(state.Description) {
+		input.Description = plan.Description.ValueStringPointer()
+	}
+
+	if !plan.Hostname.Equal(state.Hostname) {
+		hostname := plan.Hostname.NormalizedHost()
+		input.Hostname = &hostname
+	}
+
+	if !plan.Port.Equal(state.Port) {
+		port := int(plan.Port.ValueInt64())
+		input.Port = &port
+	}
+
+	// An empty string clears a connection attribute that was removed from the configuration
+	if !plan.AccountLocator.Equal(state.AccountLocator) {
+		accountLocator := plan.AccountLocator.ValueString()
+		input.AccountLocator = &accountLocator
+	}
+
+	if !plan.Warehouse.Equal(state.Warehouse) {
+		warehouse := plan.Warehouse.ValueString()
+		input.Warehouse = &warehouse
+	}
+
+	if !plan.HTTPPath.Equal(state.HTTPPath) {
+		httpPath := plan.HTTPPath.ValueString()
+		input.HTTPPath = &httpPath
+	}
+
+	if !plan.ConnectionOptions.Equal(state.ConnectionOptions) {
+		input.ConnectionOptions = connectionOptionsFromObject(plan.ConnectionOptions)
+
+		// Removing connection_options clears them
+		if input.ConnectionOptions == nil {
+			input.ConnectionOptions = &client.RepoConnectionOptions{}
+		}
 	}
 
 	// Call the API to update the repo
@@ -297,11 +466,24 @@ func (r *RepoResource) mapRepoToModel(repo *client.Repo, model *RepoResourceMode
 	model.Name = types.StringValue(repo.Name)
 	model.Description = types.StringValue(repo.Description)
 	model.Type = types.StringValue(repo.Type)
-	model.Hostname = types.StringValue(repo.Hostname)
+	model.Hostname = service.NewHostValue(repo.Hostname)
 	model.Port = types.Int64Value(int64(repo.Port))
+	model.AccountLocator = optionalStringValue(repo.AccountLocator)
+	model.Warehouse = optionalStringValue(repo.Warehouse)
+	model.HTTPPath = optionalStringValue(repo.HTTPPath)
+	model.ConnectionOptions = connectionOptionsToObject(repo.ConnectionOptions)
 	model.UserCount = types.Int64Value(int64(repo.UserCount))
 	model.ServiceUserCount = types.Int64Value(int64(repo.ServiceUserCount))
 	model.BindingCount = types.Int64Value(int64(repo.BindingCount))
 	model.CreatedAt = types.StringValue(repo.CreatedAt)
 	model.UpdatedAt = types.StringValue(repo.UpdatedAt)
 }
+
+// optionalStringValue maps an empty API string to null so unset optional attributes stay unset
+func optionalStringValue(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
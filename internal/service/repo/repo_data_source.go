@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 var _ datasource.DataSource = &RepoDataSource{}
@@ -28,17 +29,21 @@ type RepoDataSource struct {
 }
 
 type RepoDataSourceModel struct {
-	Name             types.String `tfsdk:"name"`
-	Description      types.String `tfsdk:"description"`
-	Type             types.String `tfsdk:"type"`
-	Hostname         types.String `tfsdk:"hostname"`
-	Port             types.Int64  `tfsdk:"port"`
-	UserCount        types.Int64  `tfsdk:"user_count"`
-	ServiceUserCount types.Int64  `tfsdk:"service_user_count"`
-	BindingCount     types.Int64  `tfsdk:"binding_count"`
-	OrgID            types.String `tfsdk:"org_id"`
-	CreatedAt        types.String `tfsdk:"created_at"`
-	UpdatedAt        types.String `tfsdk:"updated_at"`
+	Name              types.String          `tfsdk:"name"`
+	Description       types.String          `tfsdk:"description"`
+	Type              types.String          `tfsdk:"type"`
+	Hostname          types.String          `tfsdk:"hostname"`
+	Port              types.Int64           `tfsdk:"port"`
+	AccountLocator    types.String          `tfsdk:"account_locator"`
+	Warehouse         types.String          `tfsdk:"warehouse"`
+	HTTPPath          types.String          `tfsdk:"http_path"`
+	ConnectionOptions basetypes.ObjectValue `tfsdk:"connection_options"`
+	UserCount         types.Int64           `tfsdk:"user_count"`
+	ServiceUserCount  types.Int64           `tfsdk:"service_user_count"`
+	BindingCount      types.Int64           `tfsdk:"binding_count"`
+	OrgID             types.String          `tfsdk:"org_id"`
+	CreatedAt         types.String          `tfsdk:"created_at"`
+	UpdatedAt         types.String          `tfsdk:"updated_at"`
 }
 
 func (d *RepoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -77,6 +82,19 @@ func (d *RepoDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				Description: "Port number of the repository.",
 				Computed:    true,
 			},
+			"account_locator": schema.StringAttribute{
+				Description: "Snowflake account locator. Only set for Snowflake repositories.",
+				Computed:    true,
+			},
+			"warehouse": schema.StringAttribute{
+				Description: "Snowflake virtual warehouse used for queries. Only set for Snowflake repositories.",
+				Computed:    true,
+			},
+			"http_path": schema.StringAttribute{
+				Description: "HTTP path of the Databricks SQL warehouse. Only set for Databricks repositories.",
+				Computed:    true,
+			},
+			"connection_options": connectionOptionsDataSourceAttribute(),
 			"user_count": schema.Int64Attribute{
 				Description: "Number of users associated with this repository.",
 				Computed:    true,
@@ -167,6 +185,10 @@ func (d *RepoDataSource) mapRepoToModel(repo *client.Repo, model *RepoDataSource
 	model.Type = types.StringValue(repo.Type)
 	model.Hostname = types.StringValue(repo.Hostname)
 	model.Port = types.Int64Value(int64(repo.Port))
+	model.AccountLocator = optionalStringValue(repo.AccountLocator)
+	model.Warehouse = optionalStringValue(repo.Warehouse)
+	model.HTTPPath = optionalStringValue(repo.HTTPPath)
+	model.ConnectionOptions = connectionOptionsToObject(repo.ConnectionOptions)
 	model.UserCount = types.Int64Value(int64(repo.UserCount))
 	model.ServiceUserCount = types.Int64Value(int64(repo.ServiceUserCount))
 	model.BindingCount = types.Int64Value(int64(repo.BindingCount))
@@ -174,3 +196,65 @@ func (d *RepoDataSource) mapRepoToModel(repo *client.Repo, model *RepoDataSource
 	model.CreatedAt = types.StringValue(repo.CreatedAt)
 	model.UpdatedAt = types.StringValue(repo.UpdatedAt)
 }
+
+// connectionOptionsDataSourceAttribute is shared by the singular and plural repository data sources.
+func connectionOptionsDataSourceAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Type-specific connection settings. Only the block matching the repository type is set.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			connectionOptionsOracle: schema.SingleNestedAttribute{
+				Description: "Oracle connection settings.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"service_name": schema.StringAttribute{
+						Description: "Oracle service name to connect to.",
+						Computed:    true,
+					},
+					"sid": schema.StringAttribute{
+						Description: "Oracle system identifier (SID) to connect to.",
+						Computed:    true,
+					},
+				},
+			},
+			connectionOptionsPostgres: schema.SingleNestedAttribute{
+				Description: "Postgres and Redshift connection settings.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"database": schema.StringAttribute{
+						Description: "Database to connect to when a client does not name one.",
+						Computed:    true,
+					},
+					"sslmode": schema.StringAttribute{
+						Description: "libpq sslmode.",
+						Computed:    true,
+					},
+				},
+			},
+			connectionOptionsMSSQL: schema.SingleNestedAttribute{
+				Description: "MSSQL connection settings.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"instance_name": schema.StringAttribute{
+						Description: "Named instance to connect to.",
+						Computed:    true,
+					},
+					"encrypt": schema.BoolAttribute{
+						Description: "Whether connections to the repository are encrypted.",
+						Computed:    true,
+					},
+				},
+			},
+			connectionOptionsMySQL: schema.SingleNestedAttribute{
+				Description: "MySQL connection settings.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"tls_mode": schema.StringAttribute{
+						Description: "TLS mode.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -104,7 +105,7 @@ func (r *ServiceUserResource) Schema(ctx context.Context, req resource.SchemaReq
 		},
 	}
 
-	for name, attribute := range credentialProviderSchemaAttributes() {
+	for name, attribute := range service.CredentialProviderSchemaAttributes() {
 		attributes[name] = attribute
 	}
 
@@ -141,7 +142,7 @@ func (r *ServiceUserResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	if err := validateSingleCredentialProvider(plan.AWSSecretsManager, plan.AzureKeyVault, plan.EnvironmentVariable, plan.SecretFile); err != nil {
+	if err := service.ValidateSingleCredentialProvider(plan.AWSSecretsManager, plan.AzureKeyVault, plan.EnvironmentVariable, plan.SecretFile); err != nil {
 		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
 
 		return
@@ -152,7 +153,7 @@ func (r *ServiceUserResource) Create(ctx context.Context, req resource.CreateReq
 		Resource: plan.Resource.ValueString(),
 	}
 
-	input.AWSSecretsManager, input.AzureKeyVault, input.EnvironmentVariable, input.SecretFile = credentialProvidersFromObjects(
+	input.AWSSecretsManager, input.AzureKeyVault, input.EnvironmentVariable, input.SecretFile = service.CredentialProvidersFromObjects(
 		plan.AWSSecretsManager, plan.AzureKeyVault, plan.EnvironmentVariable, plan.SecretFile,
 	)
 
@@ -230,7 +231,7 @@ func (r *ServiceUserResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	if err := validateSingleCredentialProvider(plan.AWSSecretsManager, plan.AzureKeyVault, plan.EnvironmentVariable, plan.SecretFile); err != nil {
+	if err := service.ValidateSingleCredentialProvider(plan.AWSSecretsManager, plan.AzureKeyVault, plan.EnvironmentVariable, plan.SecretFile); err != nil {
 		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
 
 		return
@@ -240,7 +241,7 @@ func (r *ServiceUserResource) Update(ctx context.Context, req resource.UpdateReq
 		Resource: plan.Resource.ValueString(),
 	}
 
-	input.AWSSecretsManager, input.AzureKeyVault, input.EnvironmentVariable, input.SecretFile = credentialProvidersFromObjects(
+	input.AWSSecretsManager, input.AzureKeyVault, input.EnvironmentVariable, input.SecretFile = service.CredentialProvidersFromObjects(
 		plan.AWSSecretsManager, plan.AzureKeyVault, plan.EnvironmentVariable, plan.SecretFile,
 	)
 
@@ -308,7 +309,7 @@ func (r *ServiceUserResource) mapServiceUserToModel(su *client.ServiceUser, mode
 		model.Resource = types.StringValue(su.Resource)
 	}
 
-	model.AWSSecretsManager, model.AzureKeyVault, model.EnvironmentVariable, model.SecretFile = credentialProvidersToObjects(
+	model.AWSSecretsManager, model.AzureKeyVault, model.EnvironmentVariable, model.SecretFile = service.CredentialProvidersToObjects(
 		su.AWSSecretsManager, su.AzureKeyVault, su.EnvironmentVariable, su.SecretFile,
 	)
 }
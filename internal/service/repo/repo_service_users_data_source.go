@@ -0,0 +1,185 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServiceUsersDataSource{}
+
+func NewServiceUsersDataSource() datasource.DataSource {
+	return &ServiceUsersDataSource{}
+}
+
+type ServiceUsersDataSource struct {
+	client *client.Client
+}
+
+type ServiceUsersDataSourceModel struct {
+	RepoName           types.String                      `tfsdk:"repo_name"`
+	CredentialProvider types.String                      `tfsdk:"credential_provider"`
+	Usernames          []types.String                    `tfsdk:"usernames"`
+	ServiceUsers       []ServiceUsersDataSourceUserModel `tfsdk:"service_users"`
+}
+
+type ServiceUsersDataSourceUserModel struct {
+	Username           types.String `tfsdk:"username"`
+	Resource           types.String `tfsdk:"resource"`
+	CredentialProvider types.String `tfsdk:"credential_provider"`
+	TaskCount          types.Int64  `tfsdk:"task_count"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+}
+
+func (d *ServiceUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_users"
+}
+
+func (d *ServiceUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for listing the service users of a repository, used for agent task authentication, with the type of credential provider each one uses. " +
+			"Credential provider settings are not returned.",
+		Attributes: map[string]schema.Attribute{
+			"repo_name": schema.StringAttribute{
+				Description: "Name of the repository.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"credential_provider": schema.StringAttribute{
+				Description: "Only return service users whose credentials come from this provider: aws_secrets_manager, azure_key_vault, environment_variable or secret_file.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(service.CredentialProviderTypes...),
+				},
+			},
+			"usernames": schema.ListAttribute{
+				Description: "Usernames of the matching service users, sorted.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"service_users": schema.ListNestedAttribute{
+				Description: "Matching service users, sorted by username.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							Description: "Database username.",
+							Computed:    true,
+						},
+						"resource": schema.StringAttribute{
+							Description: "Database entrypoint the agent connects to with this service user.",
+							Computed:    true,
+						},
+						"credential_provider": schema.StringAttribute{
+							Description: "Credential provider the user's secret is read from: aws_secrets_manager, azure_key_vault, environment_variable or secret_file.",
+							Computed:    true,
+						},
+						"task_count": schema.Int64Attribute{
+							Description: "Number of agent tasks currently using this service user.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Creation timestamp.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "Last update timestamp.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ServiceUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ServiceUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ServiceUsersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get service users from API
+	serviceUsers, err := d.client.ListServiceUsers(config.RepoName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing service users",
+			fmt.Sprintf("Could not list service users of repo %s: %s", config.RepoName.ValueString(), err.Error()),
+		)
+
+		return
+	}
+
+	// If the repo doesn't exist, return error
+	if serviceUsers == nil {
+		resp.Diagnostics.AddError(
+			"Repository not found",
+			fmt.Sprintf("Repository '%s' does not exist.", config.RepoName.ValueString()),
+		)
+
+		return
+	}
+
+	sort.Slice(serviceUsers, func(i, j int) bool {
+		return serviceUsers[i].Username < serviceUsers[j].Username
+	})
+
+	config.Usernames = []types.String{}
+	config.ServiceUsers = []ServiceUsersDataSourceUserModel{}
+
+	for _, serviceUser := range serviceUsers {
+		provider := service.CredentialProviderType(serviceUser.AWSSecretsManager, serviceUser.AzureKeyVault, serviceUser.EnvironmentVariable, serviceUser.SecretFile)
+
+		if !config.CredentialProvider.IsNull() && !provider.Equal(config.CredentialProvider) {
+			continue
+		}
+
+		config.Usernames = append(config.Usernames, types.StringValue(serviceUser.Username))
+		config.ServiceUsers = append(config.ServiceUsers, ServiceUsersDataSourceUserModel{
+			Username:           types.StringValue(serviceUser.Username),
+			Resource:           types.StringValue(serviceUser.Resource),
+			CredentialProvider: provider,
+			TaskCount:          types.Int64Value(int64(serviceUser.TaskCount)),
+			CreatedAt:          types.StringValue(serviceUser.CreatedAt),
+			UpdatedAt:          types.StringValue(serviceUser.UpdatedAt),
+		})
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package repo_test
+
+import (
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServiceUsersDataSource_basic(t *testing.T) {
+	dataSourceName := "data.altr_service_users.test"
+	repoName := acctest.RandomWithPrefixUnderscoreMaxLength("su_test", 32)
+	username := acctest.RandomWithPrefixUnderscoreMaxLength("svcuser", 32)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckServiceUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceUserResourceConfig_awsSecretsManager(repoName, username, "/test/secrets/path") + `
+data "altr_service_users" "test" {
+  repo_name  = altr_repo.test.name
+  depends_on = [altr_service_user.test]
+}
+
+data "altr_service_users" "env" {
+  repo_name           = altr_repo.test.name
+  credential_provider = "environment_variable"
+  depends_on          = [altr_service_user.test]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "service_users.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "service_users.0.username", username),
+					resource.TestCheckResourceAttr(dataSourceName, "service_users.0.resource", "ORCL"),
+					resource.TestCheckResourceAttr(dataSourceName, "service_users.0.credential_provider", "aws_secrets_manager"),
+					resource.TestCheckResourceAttr(dataSourceName, "service_users.0.task_count", "0"),
+					resource.TestCheckResourceAttr("data.altr_service_users.env", "usernames.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"strconv"
 	"strings"
 
 	"github.com/altrsoftware/terraform-provider-altr/internal/client"
@@ -27,6 +26,7 @@ import (
 var (
 	_ resource.Resource                = &RepoSidecarBindingResource{}
 	_ resource.ResourceWithImportState = &RepoSidecarBindingResource{}
+	_ resource.ResourceWithModifyPlan  = &RepoSidecarBindingResource{}
 )
 
 func NewRepoSidecarBindingResource() resource.Resource {
@@ -50,7 +50,9 @@ func (r *RepoSidecarBindingResource) Metadata(ctx context.Context, req resource.
 
 func (r *RepoSidecarBindingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a binding between a repository and a sidecar listener port.",
+		Description: "Manages a binding between a repository and a sidecar listener port. When the sidecar ID, port and repository " +
+			"name are known at plan time and both the listener and the repository already exist, the plan fails if the listener's " +
+			"database type does not match the repository type.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Unique identifier for the binding (sidecar_id:port:repo_name).",
@@ -114,6 +116,81 @@ func (r *RepoSidecarBindingResource) Configure(ctx context.Context, req resource
 	r.client = client
 }
 
+func (r *RepoSidecarBindingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan RepoSidecarBindingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values from resources that are not created yet cannot be looked up
+	if plan.SidecarID.IsUnknown() || plan.RepoName.IsUnknown() || plan.Port.IsUnknown() {
+		return
+	}
+
+	// Only check new bindings; an existing binding is refreshed by Read instead
+	if !req.State.Raw.IsNull() {
+		var state RepoSidecarBindingResourceModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if state.SidecarID.Equal(plan.SidecarID) && state.RepoName.Equal(plan.RepoName) && state.Port.Equal(plan.Port) {
+			return
+		}
+	}
+
+	listener, err := r.client.GetSidecarListener(plan.SidecarID.ValueString(), int(plan.Port.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading sidecar listener",
+			fmt.Sprintf("Could not read sidecar listener for sidecar %s on port %d: %s", plan.SidecarID.ValueString(), plan.Port.ValueInt64(), err.Error()),
+		)
+
+		return
+	}
+
+	// A listener that does not exist yet may be registered in the same apply; a port that is
+	// really missing is reported by the API when the binding is created
+	if listener == nil {
+		return
+	}
+
+	repo, err := r.client.GetRepo(plan.RepoName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading repository",
+			"Could not read repository "+plan.RepoName.ValueString()+": "+err.Error(),
+		)
+
+		return
+	}
+
+	// A repository that does not exist yet may be created in the same apply
+	if repo == nil {
+		return
+	}
+
+	if !strings.EqualFold(repo.Type, listener.DatabaseType) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("port"),
+			"Database Type Mismatch",
+			fmt.Sprintf("The listener on port %d of sidecar %s accepts %s connections, but repository %s is of type %s.",
+				plan.Port.ValueInt64(), plan.SidecarID.ValueString(), listener.DatabaseType, repo.Name, repo.Type),
+		)
+	}
+}
+
 func (r *RepoSidecarBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan RepoSidecarBindingResourceModel
 
@@ -226,25 +303,11 @@ func (r *RepoSidecarBindingResource) Delete(ctx context.Context, req resource.De
 
 func (r *RepoSidecarBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Expected import ID format: "sidecar_id:port:repo_name"
-	parts := strings.Split(req.ID, ":")
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			"Expected import ID in format: sidecar_id:port:repo_name",
-		)
-
-		return
-	}
-
-	sidecarID := parts[0]
-	portStr := parts[1]
-	repoName := parts[2]
-
-	port, err := strconv.ParseInt(portStr, 10, 64)
+	sidecarID, port, repoName, err := service.ParseBindingID(req.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Invalid Port in Import ID",
-			"Port must be a valid integer: "+err.Error(),
+			"Invalid Import ID",
+			err.Error(),
 		)
 
 		return
@@ -263,8 +326,5 @@ func (r *RepoSidecarBindingResource) mapBindingToModel(binding *client.RepoSidec
 	model.Port = types.Int64Value(int64(binding.Port))
 
 	// Set the ID
-	model.ID = types.StringValue(fmt.Sprintf("%s:%d:%s",
-		binding.SidecarID,
-		binding.Port,
-		binding.RepoName))
+	model.ID = types.StringValue(service.BindingID(binding.SidecarID, int64(binding.Port), binding.RepoName))
 }
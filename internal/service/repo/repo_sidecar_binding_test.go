@@ -188,6 +188,59 @@ func TestAccRepoSidecarBindingResource_nonExistentListener(t *testing.T) {
 	})
 }
 
+func TestAccRepoSidecarBindingResource_newListenerOnExistingSidecar(t *testing.T) {
+	resourceName := "altr_repo_sidecar_binding.test"
+	sidecarName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	sidecarHostname := fmt.Sprintf("%s.example.altr.com", uuid.New().String())
+	repoName := fmt.Sprintf("repo_%d", rand.Int())
+	dbType := "Oracle"
+	repoHostname := fmt.Sprintf("%s.example.altr.com", uuid.New().String())
+	repoPort := sdkacctest.RandIntRange(1, 65535)
+	listenerPort := sdkacctest.RandIntRange(1, 65535)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRepoSidecarBindingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepoSidecarBindingResourceConfig_sidecarOnly(sidecarName, sidecarHostname, pubKeyExample1),
+			},
+			{
+				// The sidecar ID is known at plan time but the listener is only registered during apply
+				Config: testAccRepoSidecarBindingResourceConfig_sidecarOnly(sidecarName, sidecarHostname, pubKeyExample1) +
+					testAccRepoSidecarBindingResourceConfig_existingSidecar(sidecarName, repoName, dbType, repoHostname, "19.0.0.0", repoPort, listenerPort),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepoSidecarBindingExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "sidecar_id", "altr_sidecar.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "port", strconv.Itoa(listenerPort)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRepoSidecarBindingResource_databaseTypeMismatch(t *testing.T) {
+	sidecarName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	sidecarHostname := fmt.Sprintf("%s.example.altr.com", uuid.New().String())
+	repoName := fmt.Sprintf("repo_%d", rand.Int())
+	repoHostname := fmt.Sprintf("%s.example.altr.com", uuid.New().String())
+	repoPort := sdkacctest.RandIntRange(1, 65535)
+	listenerPort := sdkacctest.RandIntRange(1, 65535)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRepoSidecarBindingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRepoSidecarBindingResourceConfig_basic(sidecarName, sidecarHostname, pubKeyExample1, repoName, "Postgres", repoHostname, "Oracle", "19.0.0.0", repoPort, listenerPort),
+				ExpectError: regexp.MustCompile(`Database Type Mismatch`),
+			},
+		},
+	})
+}
+
 func TestAccRepoSidecarBindingResource_disappears(t *testing.T) {
 	resourceName := "altr_repo_sidecar_binding.test"
 	sidecarName := sdkacctest.RandomWithPrefix("tf-acc-test")
@@ -546,6 +599,46 @@ resource "altr_repo_sidecar_binding" "test" {
 `, sidecarName, sidecarHostname, sidecarPubKey1, repoName, repoType, repoHostname, repoPort, listenerPort, listenerDatabaseType, listenerAdvertisedVersion)
 }
 
+func testAccRepoSidecarBindingResourceConfig_sidecarOnly(sidecarName, sidecarHostname, sidecarPubKey1 string) string {
+	return fmt.Sprintf(`
+resource "altr_sidecar" "test" {
+  name         = %[1]q
+  hostname     = %[2]q
+  public_key_1 = %[3]q
+}
+`, sidecarName, sidecarHostname, sidecarPubKey1)
+}
+
+func testAccRepoSidecarBindingResourceConfig_existingSidecar(sidecarName, repoName, repoType, repoHostname, listenerAdvertisedVersion string, repoPort, listenerPort int) string {
+	return fmt.Sprintf(`
+data "altr_sidecar" "test" {
+  name = %[1]q
+}
+
+resource "altr_repo" "test" {
+  name     = %[2]q
+  type     = %[3]q
+  hostname = %[4]q
+  port     = %[5]d
+}
+
+resource "altr_sidecar_listener" "test" {
+  sidecar_id         = data.altr_sidecar.test.id
+  port               = %[6]d
+  database_type      = %[3]q
+  advertised_version = %[7]q
+}
+
+resource "altr_repo_sidecar_binding" "test" {
+  sidecar_id = data.altr_sidecar.test.id
+  repo_name  = altr_repo.test.name
+  port       = %[6]d
+
+  depends_on = [altr_sidecar_listener.test]
+}
+`, sidecarName, repoName, repoType, repoHostname, repoPort, listenerPort, listenerAdvertisedVersion)
+}
+
 var pubKeyExample1 = `-----BEGIN PUBLIC KEY-----
 MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAp/TpUtMCmMLzQ+vTzuel
 XudSzqzsgjEj7dWrpNgY+fwo8r6oVx19pDbeNATlCMrmQM942aGmL2kdBhhPrZuC
@@ -0,0 +1,359 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &RepoSidecarBindingsResource{}
+	_ resource.ResourceWithImportState = &RepoSidecarBindingsResource{}
+)
+
+func NewRepoSidecarBindingsResource() resource.Resource {
+	return &RepoSidecarBindingsResource{}
+}
+
+// RepoSidecarBindingsResource owns the complete set of sidecar bindings of a
+// repository. Bindings created outside of Terraform are removed on apply and
+// show up as drift on refresh.
+type RepoSidecarBindingsResource struct {
+	client *client.Client
+}
+
+type RepoSidecarBindingsResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	RepoName types.String `tfsdk:"repo_name"`
+	Bindings types.Set    `tfsdk:"bindings"`
+}
+
+var bindingAttrTypes = map[string]attr.Type{
+	"sidecar_id": types.StringType,
+	"port":       types.Int64Type,
+}
+
+func (r *RepoSidecarBindingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repo_sidecar_bindings"
+}
+
+func (r *RepoSidecarBindingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Authoritatively manages every sidecar binding of a repository. Bindings that are not in the configuration, " +
+			"including ones created outside of Terraform, are removed. Do not combine with altr_repo_sidecar_binding " +
+			"resources for the same repository.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the binding set (the repository name).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repo_name": schema.StringAttribute{
+				Description: "Name of the repository.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bindings": schema.SetNestedAttribute{
+				Description: "Complete set of sidecar listener ports the repository is bound to. An empty set removes every binding.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sidecar_id": schema.StringAttribute{
+							Description: "ID of the sidecar.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(
+									regexp.MustCompile(service.UUIDv4Regex),
+									"must be a valid UUIDv4",
+								),
+							},
+						},
+						"port": schema.Int64Attribute{
+							Description: "Sidecar listener port to bind to the repository.",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *RepoSidecarBindingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RepoSidecarBindingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RepoSidecarBindingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Take over the repository, creating missing bindings and removing extras
+	bindings, diags := r.reconcileBindings(plan.RepoName.ValueString(), bindingsFromSet(plan.Bindings))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.RepoName
+	plan.Bindings = bindingsToSet(bindings)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *RepoSidecarBindingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RepoSidecarBindingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bindings, err := r.client.ListRepoBindings(state.RepoName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading repo sidecar bindings",
+			"Could not list sidecar bindings of repo "+state.RepoName.ValueString()+": "+err.Error(),
+		)
+
+		return
+	}
+
+	// Listing the bindings of a deleted repo returns nothing, so tell the two apart
+	if len(bindings) == 0 {
+		repo, err := r.client.GetRepo(state.RepoName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading repo",
+				"Could not read repo "+state.RepoName.ValueString()+": "+err.Error(),
+			)
+
+			return
+		}
+
+		if repo == nil {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+	}
+
+	state.ID = state.RepoName
+	state.Bindings = bindingsToSet(bindings)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *RepoSidecarBindingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RepoSidecarBindingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reconcile against the repo rather than the prior state so bindings created
+	// since the last refresh are removed too
+	bindings, diags := r.reconcileBindings(plan.RepoName.ValueString(), bindingsFromSet(plan.Bindings))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.RepoName
+	plan.Bindings = bindingsToSet(bindings)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *RepoSidecarBindingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RepoSidecarBindingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, binding := range bindingsFromSet(state.Bindings) {
+		err := r.client.DeleteRepoSidecarBinding(binding.SidecarID, state.RepoName.ValueString(), binding.Port)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting repo sidecar binding",
+				fmt.Sprintf("Could not delete binding to sidecar %s on port %d, unexpected error: %s", binding.SidecarID, binding.Port, err.Error()),
+			)
+
+			return
+		}
+	}
+}
+
+func (r *RepoSidecarBindingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expected import ID format: "repo_name"
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repo_name"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// reconcileBindings makes the sidecar bindings of the repo match desired and
+// returns the resulting bindings. Extra bindings are removed before missing ones
+// are created so the repository is never reachable through more ports than
+// configured.
+func (r *RepoSidecarBindingsResource) reconcileBindings(repoName string, desired []client.RepoSidecarBinding) ([]client.RepoSidecarBinding, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	current, err := r.client.ListRepoBindings(repoName)
+	if err != nil {
+		diags.AddError(
+			"Error reading repo sidecar bindings",
+			"Could not list sidecar bindings of repo "+repoName+": "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	for _, binding := range desired {
+		wanted[service.BindingID(binding.SidecarID, int64(binding.Port), repoName)] = true
+	}
+
+	existing := make(map[string]bool, len(current))
+
+	for _, binding := range current {
+		id := service.BindingID(binding.SidecarID, int64(binding.Port), repoName)
+		if wanted[id] {
+			existing[id] = true
+
+			continue
+		}
+
+		if err := r.client.DeleteRepoSidecarBinding(binding.SidecarID, repoName, binding.Port); err != nil {
+			diags.AddError(
+				"Error deleting repo sidecar binding",
+				fmt.Sprintf("Could not delete binding to sidecar %s on port %d, unexpected error: %s", binding.SidecarID, binding.Port, err.Error()),
+			)
+
+			return nil, diags
+		}
+	}
+
+	for _, binding := range desired {
+		if existing[service.BindingID(binding.SidecarID, int64(binding.Port), repoName)] {
+			continue
+		}
+
+		if err := r.client.CreateRepoSidecarBinding(binding.SidecarID, repoName, binding.Port); err != nil {
+			diags.AddError(
+				"Error creating repo sidecar binding",
+				fmt.Sprintf("Could not bind to sidecar %s on port %d, unexpected error: %s", binding.SidecarID, binding.Port, err.Error()),
+			)
+
+			return nil, diags
+		}
+	}
+
+	bindings, err := r.client.ListRepoBindings(repoName)
+	if err != nil {
+		diags.AddError(
+			"Error reading repo sidecar bindings",
+			"Could not list sidecar bindings of repo "+repoName+": "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return bindings, diags
+}
+
+// Helper function to convert the bindings set to client bindings
+func bindingsFromSet(set types.Set) []client.RepoSidecarBinding {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	var bindings []client.RepoSidecarBinding
+
+	for _, element := range set.Elements() {
+		bindingObj, ok := element.(types.Object)
+		if !ok {
+			continue // Skip invalid bindings
+		}
+
+		bindingAttrs := bindingObj.Attributes()
+
+		sidecarID, _ := bindingAttrs["sidecar_id"].(types.String)
+		port, _ := bindingAttrs["port"].(types.Int64)
+
+		bindings = append(bindings, client.RepoSidecarBinding{
+			SidecarID: sidecarID.ValueString(),
+			Port:      int(port.ValueInt64()),
+		})
+	}
+
+	return bindings
+}
+
+// Helper function to convert client bindings to the bindings set
+func bindingsToSet(bindings []client.RepoSidecarBinding) types.Set {
+	elements := make([]attr.Value, 0, len(bindings))
+
+	for _, binding := range bindings {
+		elements = append(elements, types.ObjectValueMust(bindingAttrTypes, map[string]attr.Value{
+			"sidecar_id": types.StringValue(binding.SidecarID),
+			"port":       types.Int64Value(int64(binding.Port)),
+		}))
+	}
+
+	return types.SetValueMust(types.ObjectType{AttrTypes: bindingAttrTypes}, elements)
+}
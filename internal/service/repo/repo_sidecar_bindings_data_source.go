@@ -0,0 +1,189 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &RepoSidecarBindingsDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &RepoSidecarBindingsDataSource{}
+)
+
+func NewRepoSidecarBindingsDataSource() datasource.DataSource {
+	return &RepoSidecarBindingsDataSource{}
+}
+
+type RepoSidecarBindingsDataSource struct {
+	client *client.Client
+}
+
+type RepoSidecarBindingsDataSourceModel struct {
+	SidecarID types.String                                `tfsdk:"sidecar_id"`
+	RepoName  types.String                                `tfsdk:"repo_name"`
+	Bindings  []RepoSidecarBindingsDataSourceBindingModel `tfsdk:"bindings"`
+}
+
+type RepoSidecarBindingsDataSourceBindingModel struct {
+	ID        types.String `tfsdk:"id"`
+	SidecarID types.String `tfsdk:"sidecar_id"`
+	Port      types.Int64  `tfsdk:"port"`
+	RepoName  types.String `tfsdk:"repo_name"`
+}
+
+func (d *RepoSidecarBindingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repo_sidecar_bindings"
+}
+
+func (d *RepoSidecarBindingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for listing the repository sidecar bindings of a sidecar or of a repository. " +
+			"When both sidecar_id and repo_name are set, only bindings matching both are returned.",
+		Attributes: map[string]schema.Attribute{
+			"sidecar_id": schema.StringAttribute{
+				Description: "ID of the sidecar whose bindings are listed.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(service.UUIDv4Regex),
+						"must be a valid UUIDv4",
+					),
+				},
+			},
+			"repo_name": schema.StringAttribute{
+				Description: "Name of the repository whose bindings are listed.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"bindings": schema.ListNestedAttribute{
+				Description: "Matching bindings, sorted by sidecar ID, port and repository name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Binding ID in the sidecar_id:port:repo_name format.",
+							Computed:    true,
+						},
+						"sidecar_id": schema.StringAttribute{
+							Description: "ID of the sidecar.",
+							Computed:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "Sidecar listener port bound to the repository.",
+							Computed:    true,
+						},
+						"repo_name": schema.StringAttribute{
+							Description: "Name of the repository.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RepoSidecarBindingsDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("sidecar_id"),
+			path.MatchRoot("repo_name"),
+		),
+	}
+}
+
+func (d *RepoSidecarBindingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RepoSidecarBindingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config RepoSidecarBindingsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		bindings []client.RepoSidecarBinding
+		err      error
+	)
+
+	// Prefer the sidecar listing and filter it by repo when both are given
+	if !config.SidecarID.IsNull() {
+		bindings, err = d.client.ListSidecarBindings(config.SidecarID.ValueString())
+	} else {
+		bindings, err = d.client.ListRepoBindings(config.RepoName.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing repository sidecar bindings",
+			"Could not list repository sidecar bindings, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	sort.Slice(bindings, func(i, j int) bool {
+		if bindings[i].SidecarID != bindings[j].SidecarID {
+			return bindings[i].SidecarID < bindings[j].SidecarID
+		}
+
+		if bindings[i].Port != bindings[j].Port {
+			return bindings[i].Port < bindings[j].Port
+		}
+
+		return bindings[i].RepoName < bindings[j].RepoName
+	})
+
+	config.Bindings = []RepoSidecarBindingsDataSourceBindingModel{}
+
+	for _, binding := range bindings {
+		if !config.RepoName.IsNull() && binding.RepoName != config.RepoName.ValueString() {
+			continue
+		}
+
+		config.Bindings = append(config.Bindings, RepoSidecarBindingsDataSourceBindingModel{
+			ID:        types.StringValue(service.BindingID(binding.SidecarID, int64(binding.Port), binding.RepoName)),
+			SidecarID: types.StringValue(binding.SidecarID),
+			Port:      types.Int64Value(int64(binding.Port)),
+			RepoName:  types.StringValue(binding.RepoName),
+		})
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
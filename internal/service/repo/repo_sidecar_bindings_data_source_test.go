@@ -0,0 +1,98 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package repo_test
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
+	"github.com/google/uuid"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRepoSidecarBindingsDataSource_basic(t *testing.T) {
+	sidecarResourceName := "altr_sidecar.test"
+	sidecarName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	sidecarHostname := fmt.Sprintf("%s.example.altr.com", uuid.New().String())
+	repoName := fmt.Sprintf("repo_%d", rand.Int())
+	dbType := "Oracle"
+	repoHostname := fmt.Sprintf("%s.example.altr.com", uuid.New().String())
+	repoPort := sdkacctest.RandIntRange(1, 65535)
+	listener1Port := sdkacctest.RandIntRange(1, 32767)
+	listener2Port := sdkacctest.RandIntRange(32768, 65535)
+	listenerAdvertisedVersion := "19.0.0.0"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRepoSidecarBindingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepoSidecarBindingsDataSourceConfig(sidecarName, sidecarHostname, pubKeyExample1, repoName, dbType, repoHostname, dbType, listenerAdvertisedVersion, repoPort, listener1Port, listener2Port),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.altr_repo_sidecar_bindings.by_sidecar", "bindings.#", "2"),
+					resource.TestCheckResourceAttrPair("data.altr_repo_sidecar_bindings.by_sidecar", "bindings.0.sidecar_id", sidecarResourceName, "id"),
+					resource.TestCheckResourceAttr("data.altr_repo_sidecar_bindings.by_sidecar", "bindings.0.port", strconv.Itoa(listener1Port)),
+					resource.TestCheckResourceAttr("data.altr_repo_sidecar_bindings.by_sidecar", "bindings.0.repo_name", repoName),
+					resource.TestCheckResourceAttrPair("data.altr_repo_sidecar_bindings.by_sidecar", "bindings.0.id", "altr_repo_sidecar_binding.test1", "id"),
+					resource.TestCheckResourceAttr("data.altr_repo_sidecar_bindings.by_sidecar", "bindings.1.port", strconv.Itoa(listener2Port)),
+					resource.TestCheckResourceAttr("data.altr_repo_sidecar_bindings.by_repo", "bindings.#", "2"),
+					resource.TestCheckResourceAttrPair("data.altr_repo_sidecar_bindings.by_repo", "bindings.1.id", "altr_repo_sidecar_binding.test2", "id"),
+					resource.TestCheckResourceAttr("data.altr_repo_sidecar_bindings.by_both", "bindings.#", "2"),
+					resource.TestCheckResourceAttr("data.altr_repo_sidecar_bindings.other_repo", "bindings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRepoSidecarBindingsDataSource_missingFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "altr_repo_sidecar_bindings" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`At least one of these attributes must be configured: \[sidecar_id,repo_name\]`),
+			},
+		},
+	})
+}
+
+func testAccRepoSidecarBindingsDataSourceConfig(sidecarName, sidecarHostname, sidecarPubKey1, repoName, repoType, repoHostname, listenerDatabaseType, listenerAdvertisedVersion string, repoPort, listener1Port, listener2Port int) string {
+	return testAccRepoSidecarBindingResourceConfig_multiplePorts(sidecarName, sidecarHostname, sidecarPubKey1, repoName, repoType, repoHostname, listenerDatabaseType, listenerAdvertisedVersion, repoPort, listener1Port, listener2Port) + `
+data "altr_repo_sidecar_bindings" "by_sidecar" {
+  sidecar_id = altr_sidecar.test.id
+
+  depends_on = [altr_repo_sidecar_binding.test1, altr_repo_sidecar_binding.test2]
+}
+
+data "altr_repo_sidecar_bindings" "by_repo" {
+  repo_name = altr_repo.test.name
+
+  depends_on = [altr_repo_sidecar_binding.test1, altr_repo_sidecar_binding.test2]
+}
+
+data "altr_repo_sidecar_bindings" "by_both" {
+  sidecar_id = altr_sidecar.test.id
+  repo_name  = altr_repo.test.name
+
+  depends_on = [altr_repo_sidecar_binding.test1, altr_repo_sidecar_binding.test2]
+}
+
+data "altr_repo_sidecar_bindings" "other_repo" {
+  sidecar_id = altr_sidecar.test.id
+  repo_name  = "not_${altr_repo.test.name}"
+
+  depends_on = [altr_repo_sidecar_binding.test1, altr_repo_sidecar_binding.test2]
+}
+`
+}
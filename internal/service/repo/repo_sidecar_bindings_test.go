@@ -0,0 +1,259 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package repo_test
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/google/uuid"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccRepoSidecarBindingsResource_basic(t *testing.T) {
+	resourceName := "altr_repo_sidecar_bindings.test"
+	repoResourceName := "altr_repo.test"
+	sidecarResourceName := "altr_sidecar.test"
+	sidecarName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	sidecarHostname := fmt.Sprintf("%s.example.altr.com", uuid.New().String())
+	repoName := fmt.Sprintf("repo_%d", rand.Int())
+	repoHostname := fmt.Sprintf("%s.example.altr.com", uuid.New().String())
+	port1 := sdkacctest.RandIntRange(3000, 6000)
+	port2 := sdkacctest.RandIntRange(6001, 9000)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRepoSidecarBindingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepoSidecarBindingsResourceConfig(sidecarName, sidecarHostname, pubKeyExample1, repoName, repoHostname, port1, port2, `
+    {
+      sidecar_id = altr_sidecar.test.id
+      port       = altr_sidecar_listener.test1.port
+    },
+    {
+      sidecar_id = altr_sidecar.test.id
+      port       = altr_sidecar_listener.test2.port
+    },
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", repoResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "bindings.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "bindings.*.sidecar_id", sidecarResourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "bindings.*", map[string]string{
+						"port": strconv.Itoa(port1),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "bindings.*", map[string]string{
+						"port": strconv.Itoa(port2),
+					}),
+				),
+			},
+			{
+				Config: testAccRepoSidecarBindingsResourceConfig(sidecarName, sidecarHostname, pubKeyExample1, repoName, repoHostname, port1, port2, `
+    {
+      sidecar_id = altr_sidecar.test.id
+      port       = altr_sidecar_listener.test2.port
+    },
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bindings.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "bindings.*", map[string]string{
+						"port": strconv.Itoa(port2),
+					}),
+					testAccCheckRepoSidecarBindingsCount(resourceName, 1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRepoSidecarBindingsResource_removesUnmanaged(t *testing.T) {
+	resourceName := "altr_repo_sidecar_bindings.test"
+	sidecarName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	sidecarHostname := fmt.Sprintf("%s.example.altr.com", uuid.New().String())
+	repoName := fmt.Sprintf("repo_%d", rand.Int())
+	repoHostname := fmt.Sprintf("%s.example.altr.com", uuid.New().String())
+	port1 := sdkacctest.RandIntRange(3000, 6000)
+	port2 := sdkacctest.RandIntRange(6001, 9000)
+	config := testAccRepoSidecarBindingsResourceConfig(sidecarName, sidecarHostname, pubKeyExample1, repoName, repoHostname, port1, port2, `
+    {
+      sidecar_id = altr_sidecar.test.id
+      port       = altr_sidecar_listener.test1.port
+    },
+`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRepoSidecarBindingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepoSidecarBindingsAddUnmanaged(resourceName, port2),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bindings.#", "1"),
+					testAccCheckRepoSidecarBindingsCount(resourceName, 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRepoSidecarBindingsResource_sidecarIDValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "altr_repo_sidecar_bindings" "test" {
+  repo_name = "example"
+
+  bindings = [
+    {
+      sidecar_id = "not-a-uuid"
+      port       = 1521
+    },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile(`must be a valid UUIDv4`),
+			},
+		},
+	})
+}
+
+func testAccRepoSidecarBindingsClient() (*client.Client, error) {
+	return client.NewClient(
+		acctest.TestGetEnv("ALTR_ORG_ID", "test-org"),
+		acctest.TestGetEnv("ALTR_API_KEY", "test-key"),
+		acctest.TestGetEnv("ALTR_SECRET", "test-secret"),
+		acctest.TestGetEnv("ALTR_BASE_URL", ""),
+	)
+}
+
+func testAccCheckRepoSidecarBindingsAddUnmanaged(resourceName string, port int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		sidecar, ok := s.RootModule().Resources["altr_sidecar.test"]
+		if !ok {
+			return fmt.Errorf("Not found: altr_sidecar.test")
+		}
+
+		conn, err := testAccRepoSidecarBindingsClient()
+		if err != nil {
+			return fmt.Errorf("failed to create test client: %w", err)
+		}
+
+		return conn.CreateRepoSidecarBinding(sidecar.Primary.ID, rs.Primary.Attributes["repo_name"], port)
+	}
+}
+
+func testAccCheckRepoSidecarBindingsCount(resourceName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn, err := testAccRepoSidecarBindingsClient()
+		if err != nil {
+			return fmt.Errorf("failed to create test client: %w", err)
+		}
+
+		bindings, err := conn.ListRepoBindings(rs.Primary.Attributes["repo_name"])
+		if err != nil {
+			return err
+		}
+
+		if len(bindings) != expected {
+			return fmt.Errorf("expected %d repo sidecar bindings, got %d", expected, len(bindings))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRepoSidecarBindingsDestroy(s *terraform.State) error {
+	conn, err := testAccRepoSidecarBindingsClient()
+	if err != nil {
+		return fmt.Errorf("failed to create test client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "altr_repo_sidecar_bindings" {
+			continue
+		}
+
+		bindings, err := conn.ListRepoBindings(rs.Primary.Attributes["repo_name"])
+		if err != nil {
+			return err
+		}
+
+		if len(bindings) > 0 {
+			return fmt.Errorf("Repo %s still has %d sidecar bindings", rs.Primary.ID, len(bindings))
+		}
+	}
+
+	return nil
+}
+
+func testAccRepoSidecarBindingsResourceConfig(sidecarName, sidecarHostname, sidecarPubKey1, repoName, repoHostname string, port1, port2 int, bindings string) string {
+	return fmt.Sprintf(`
+resource "altr_sidecar" "test" {
+  name         = %[1]q
+  hostname     = %[2]q
+  public_key_1 = %[3]q
+}
+
+resource "altr_repo" "test" {
+  name     = %[4]q
+  type     = "Oracle"
+  hostname = %[5]q
+}
+
+resource "altr_sidecar_listener" "test1" {
+  sidecar_id         = altr_sidecar.test.id
+  port               = %[6]d
+  database_type      = "Oracle"
+  advertised_version = "19.0.0.0"
+}
+
+resource "altr_sidecar_listener" "test2" {
+  sidecar_id         = altr_sidecar.test.id
+  port               = %[7]d
+  database_type      = "Oracle"
+  advertised_version = "19.0.0.0"
+}
+
+resource "altr_repo_sidecar_bindings" "test" {
+  repo_name = altr_repo.test.name
+
+  bindings = [%[8]s  ]
+}
+`, sidecarName, sidecarHostname, sidecarPubKey1, repoName, repoHostname, port1, port2, bindings)
+}
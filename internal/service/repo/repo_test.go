@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/rand"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
@@ -14,6 +15,7 @@ import (
 	"github.com/google/uuid"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -104,6 +106,280 @@ func TestAccRepoResource_update(t *testing.T) {
 	})
 }
 
+func TestAccRepoResource_updateHostnameAndPort(t *testing.T) {
+	resourceName := "altr_repo.test"
+	rName := fmt.Sprintf("repo_%d", rand.Int())
+	rDescription := "Failover target"
+	rHostname1 := fmt.Sprintf("%s.example.altr.com", uuid.New().String())
+	rHostname2 := fmt.Sprintf("%s.example.altr.com", uuid.New().String())
+	port1 := sdkacctest.RandIntRange(1, 32767)
+	port2 := sdkacctest.RandIntRange(32768, 65535)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRepoDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepoResourceConfig_withDescription(rName, "Oracle", rHostname1, rDescription, port1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepoExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "hostname", rHostname1),
+					resource.TestCheckResourceAttr(resourceName, "port", fmt.Sprintf("%d", port1)),
+				),
+			},
+			{
+				Config: testAccRepoResourceConfig_withDescription(rName, "Oracle", rHostname2, rDescription, port2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepoExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "hostname", rHostname2),
+					resource.TestCheckResourceAttr(resourceName, "port", fmt.Sprintf("%d", port2)),
+					resource.TestCheckResourceAttr(resourceName, "description", rDescription),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRepoResource_hostnameNormalization(t *testing.T) {
+	resourceName := "altr_repo.test"
+	rName := fmt.Sprintf("repo_%d", rand.Int())
+	rHostname := strings.ToUpper(fmt.Sprintf("%s.example.altr.com.", uuid.New().String()))
+	port := sdkacctest.RandIntRange(1, 65535)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRepoDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepoResourceConfig_basic(rName, "Oracle", rHostname, port),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepoExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "hostname", rHostname),
+				),
+			},
+			{
+				Config: testAccRepoResourceConfig_basic(rName, "Oracle", rHostname, port),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccRepoResourceConfig_basic(rName, "Oracle", "[2001:DB8::0:1]", port),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepoExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "hostname", "[2001:DB8::0:1]"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRepoResource_hostnameValidation(t *testing.T) {
+	port := sdkacctest.RandIntRange(1, 65535)
+
+	for _, hostname := range []string{"-bad.example.com", "10.0.0.256", "[db.example.com]", "fe80::1%eth0"} {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acctest.PreCheck(t) },
+			ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      testAccRepoResourceConfig_basic("repo_host", "Oracle", hostname, port),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+				},
+			},
+		})
+	}
+}
+
+func TestAccRepoResource_snowflake(t *testing.T) {
+	resourceName := "altr_repo.test"
+	rName := fmt.Sprintf("repo_%d", rand.Int())
+	rHostname := fmt.Sprintf("%s.snowflakecomputing.com", sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRepoDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepoResourceConfig_snowflake(rName, rHostname, "COMPUTE_WH"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepoExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "Snowflake"),
+					resource.TestCheckResourceAttr(resourceName, "port", "443"),
+					resource.TestCheckResourceAttr(resourceName, "account_locator", "xy12345.us-east-1"),
+					resource.TestCheckResourceAttr(resourceName, "warehouse", "COMPUTE_WH"),
+					resource.TestCheckNoResourceAttr(resourceName, "http_path"),
+				),
+			},
+			{
+				Config: testAccRepoResourceConfig_snowflake(rName, rHostname, "REPORTING_WH"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepoExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "warehouse", "REPORTING_WH"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRepoResource_typeAttributesValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "altr_repo" "test" {
+  name     = "repo_snowflake"
+  type     = "Snowflake"
+  hostname = "xy12345.snowflakecomputing.com"
+}
+`,
+				ExpectError: regexp.MustCompile(`account_locator is required when type is Snowflake`),
+			},
+			{
+				Config: `
+resource "altr_repo" "test" {
+  name      = "repo_oracle"
+  type      = "Oracle"
+  hostname  = "oracle.example.com"
+  http_path = "/sql/1.0/warehouses/abc123"
+}
+`,
+				ExpectError: regexp.MustCompile(`http_path may only be set when type is Databricks`),
+			},
+		},
+	})
+}
+
+func TestAccRepoResource_connectionOptions(t *testing.T) {
+	resourceName := "altr_repo.test"
+	rName := fmt.Sprintf("repo_%d", rand.Int())
+	rHostname := fmt.Sprintf("%s.example.altr.com", uuid.New().String())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRepoDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepoResourceConfig_postgresOptions(rName, rHostname, "require"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepoExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "port", "5432"),
+					resource.TestCheckResourceAttr(resourceName, "connection_options.postgres.database", "app"),
+					resource.TestCheckResourceAttr(resourceName, "connection_options.postgres.sslmode", "require"),
+					resource.TestCheckNoResourceAttr(resourceName, "connection_options.oracle"),
+				),
+			},
+			{
+				Config: testAccRepoResourceConfig_postgresOptions(rName, rHostname, "verify-full"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepoExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "connection_options.postgres.sslmode", "verify-full"),
+				),
+			},
+			{
+				Config: testAccRepoResourceConfig_basic(rName, "Postgres", rHostname, 5432),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepoExists(resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "connection_options"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRepoResource_connectionOptionsValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "altr_repo" "test" {
+  name     = "repo_oracle"
+  type     = "Oracle"
+  hostname = "oracle.example.com"
+
+  connection_options = {
+    postgres = {
+      database = "app"
+    }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`connection_options.postgres may only be set when type is Postgres or\s+Redshift`),
+			},
+			{
+				Config: `
+resource "altr_repo" "test" {
+  name     = "repo_oracle"
+  type     = "Oracle"
+  hostname = "oracle.example.com"
+
+  connection_options = {
+    oracle = {
+      service_name = "ORCLPDB1"
+      sid          = "ORCL"
+    }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `
+resource "altr_repo" "test" {
+  name     = "repo_oracle"
+  type     = "Oracle"
+  hostname = "oracle.example.com"
+
+  connection_options = {}
+}
+`,
+				ExpectError: regexp.MustCompile(`connection_options must set the block of the repository\s+type`),
+			},
+			{
+				Config: `
+resource "altr_repo" "test" {
+  name     = "repo_mysql"
+  type     = "MySQL"
+  hostname = "mysql.example.com"
+
+  connection_options = {
+    mysql = {
+      tls_mode = "ON"
+    }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
 func TestAccRepoResource_nameValidation(t *testing.T) {
 	rHostname := fmt.Sprintf("%s.example.altr.com", "abc")
 	port := sdkacctest.RandIntRange(1, 65535)
@@ -251,3 +527,32 @@ resource "altr_repo" "test" {
 }
 `, name, repoType, hostname, port, description)
 }
+
+func testAccRepoResourceConfig_snowflake(name, hostname, warehouse string) string {
+	return fmt.Sprintf(`
+resource "altr_repo" "test" {
+  name            = %[1]q
+  type            = "Snowflake"
+  hostname        = %[2]q
+  account_locator = "xy12345.us-east-1"
+  warehouse       = %[3]q
+}
+`, name, hostname, warehouse)
+}
+
+func testAccRepoResourceConfig_postgresOptions(name, hostname, sslmode string) string {
+	return fmt.Sprintf(`
+resource "altr_repo" "test" {
+  name     = %[1]q
+  type     = "Postgres"
+  hostname = %[2]q
+
+  connection_options = {
+    postgres = {
+      database = "app"
+      sslmode  = %[3]q
+    }
+  }
+}
+`, name, hostname, sslmode)
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -91,7 +92,7 @@ func (r *RepoUserResource) Schema(ctx context.Context, req resource.SchemaReques
 		},
 	}
 
-	for name, attribute := range credentialProviderSchemaAttributes() {
+	for name, attribute := range service.CredentialProviderSchemaAttributes() {
 		attributes[name] = attribute
 	}
 
@@ -128,7 +129,7 @@ func (r *RepoUserResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	if err := validateSingleCredentialProvider(plan.AWSSecretsManager, plan.AzureKeyVault, plan.EnvironmentVariable, plan.SecretFile); err != nil {
+	if err := service.ValidateSingleCredentialProvider(plan.AWSSecretsManager, plan.AzureKeyVault, plan.EnvironmentVariable, plan.SecretFile); err != nil {
 		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
 
 		return
@@ -138,7 +139,7 @@ func (r *RepoUserResource) Create(ctx context.Context, req resource.CreateReques
 		Username: plan.Username.ValueString(),
 	}
 
-	input.AWSSecretsManager, input.AzureKeyVault, input.EnvironmentVariable, input.SecretFile = credentialProvidersFromObjects(
+	input.AWSSecretsManager, input.AzureKeyVault, input.EnvironmentVariable, input.SecretFile = service.CredentialProvidersFromObjects(
 		plan.AWSSecretsManager, plan.AzureKeyVault, plan.EnvironmentVariable, plan.SecretFile,
 	)
 
@@ -202,7 +203,7 @@ func (r *RepoUserResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	if err := validateSingleCredentialProvider(plan.AWSSecretsManager, plan.AzureKeyVault, plan.EnvironmentVariable, plan.SecretFile); err != nil {
+	if err := service.ValidateSingleCredentialProvider(plan.AWSSecretsManager, plan.AzureKeyVault, plan.EnvironmentVariable, plan.SecretFile); err != nil {
 		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
 
 		return
@@ -210,7 +211,7 @@ func (r *RepoUserResource) Update(ctx context.Context, req resource.UpdateReques
 
 	input := client.UpdateRepoUserInput{}
 
-	input.AWSSecretsManager, input.AzureKeyVault, input.EnvironmentVariable, input.SecretFile = credentialProvidersFromObjects(
+	input.AWSSecretsManager, input.AzureKeyVault, input.EnvironmentVariable, input.SecretFile = service.CredentialProvidersFromObjects(
 		plan.AWSSecretsManager, plan.AzureKeyVault, plan.EnvironmentVariable, plan.SecretFile,
 	)
 
@@ -272,7 +273,7 @@ func (r *RepoUserResource) mapRepoUserToModel(repoUser *client.RepoUser, model *
 	model.CreatedAt = types.StringValue(repoUser.CreatedAt)
 	model.UpdatedAt = types.StringValue(repoUser.UpdatedAt)
 
-	model.AWSSecretsManager, model.AzureKeyVault, model.EnvironmentVariable, model.SecretFile = credentialProvidersToObjects(
+	model.AWSSecretsManager, model.AzureKeyVault, model.EnvironmentVariable, model.SecretFile = service.CredentialProvidersToObjects(
 		repoUser.AWSSecretsManager, repoUser.AzureKeyVault, repoUser.EnvironmentVariable, repoUser.SecretFile,
 	)
 }
@@ -0,0 +1,173 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &RepoUsersDataSource{}
+
+func NewRepoUsersDataSource() datasource.DataSource {
+	return &RepoUsersDataSource{}
+}
+
+type RepoUsersDataSource struct {
+	client *client.Client
+}
+
+type RepoUsersDataSourceModel struct {
+	RepoName           types.String                   `tfsdk:"repo_name"`
+	CredentialProvider types.String                   `tfsdk:"credential_provider"`
+	Usernames          []types.String                 `tfsdk:"usernames"`
+	Users              []RepoUsersDataSourceUserModel `tfsdk:"users"`
+}
+
+type RepoUsersDataSourceUserModel struct {
+	Username           types.String `tfsdk:"username"`
+	CredentialProvider types.String `tfsdk:"credential_provider"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+}
+
+func (d *RepoUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repo_users"
+}
+
+func (d *RepoUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for listing the users of a repository with the type of credential provider each one uses. " +
+			"Credential provider settings are not returned.",
+		Attributes: map[string]schema.Attribute{
+			"repo_name": schema.StringAttribute{
+				Description: "Name of the repository.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"credential_provider": schema.StringAttribute{
+				Description: "Only return users whose credentials come from this provider: aws_secrets_manager, azure_key_vault, environment_variable or secret_file.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(service.CredentialProviderTypes...),
+				},
+			},
+			"usernames": schema.ListAttribute{
+				Description: "Usernames of the matching users, sorted.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "Matching users, sorted by username.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							Description: "Username of the repository user.",
+							Computed:    true,
+						},
+						"credential_provider": schema.StringAttribute{
+							Description: "Credential provider the user's secret is read from: aws_secrets_manager, azure_key_vault, environment_variable or secret_file.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Creation timestamp.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "Last update timestamp.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RepoUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RepoUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config RepoUsersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get repo users from API
+	repoUsers, err := d.client.ListRepoUsers(config.RepoName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing repository users",
+			fmt.Sprintf("Could not list users of repo %s: %s", config.RepoName.ValueString(), err.Error()),
+		)
+
+		return
+	}
+
+	// If the repo doesn't exist, return error
+	if repoUsers == nil {
+		resp.Diagnostics.AddError(
+			"Repository not found",
+			fmt.Sprintf("Repository '%s' does not exist.", config.RepoName.ValueString()),
+		)
+
+		return
+	}
+
+	sort.Slice(repoUsers, func(i, j int) bool {
+		return repoUsers[i].Username < repoUsers[j].Username
+	})
+
+	config.Usernames = []types.String{}
+	config.Users = []RepoUsersDataSourceUserModel{}
+
+	for _, repoUser := range repoUsers {
+		provider := service.CredentialProviderType(repoUser.AWSSecretsManager, repoUser.AzureKeyVault, repoUser.EnvironmentVariable, repoUser.SecretFile)
+
+		if !config.CredentialProvider.IsNull() && !provider.Equal(config.CredentialProvider) {
+			continue
+		}
+
+		config.Usernames = append(config.Usernames, types.StringValue(repoUser.Username))
+		config.Users = append(config.Users, RepoUsersDataSourceUserModel{
+			Username:           types.StringValue(repoUser.Username),
+			CredentialProvider: provider,
+			CreatedAt:          types.StringValue(repoUser.CreatedAt),
+			UpdatedAt:          types.StringValue(repoUser.UpdatedAt),
+		})
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package repo_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRepoUsersDataSource_basic(t *testing.T) {
+	dataSourceName := "data.altr_repo_users.all"
+	repoName := acctest.RandomWithPrefixUnderscoreMaxLength("repo_user_test", 32)
+	username1 := acctest.RandomWithPrefixUnderscoreMaxLength("repouser1", 32)
+	username2 := acctest.RandomWithPrefixUnderscoreMaxLength("repouser2", 32)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRepoUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepoUserResourceConfig_multipleUsers(repoName, username1, username2, "/test/secrets/path", "https://test.vault.azure.net/", "test-secret") + `
+data "altr_repo_users" "all" {
+  repo_name  = altr_repo.test.name
+  depends_on = [altr_repo_user.test1, altr_repo_user.test2]
+}
+
+data "altr_repo_users" "azure" {
+  repo_name           = altr_repo.test.name
+  credential_provider = "azure_key_vault"
+  depends_on          = [altr_repo_user.test1, altr_repo_user.test2]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "users.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "users.*", map[string]string{
+						"username":            username1,
+						"credential_provider": "aws_secrets_manager",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "users.*", map[string]string{
+						"username":            username2,
+						"credential_provider": "azure_key_vault",
+					}),
+					resource.TestCheckResourceAttr("data.altr_repo_users.azure", "usernames.#", "1"),
+					resource.TestCheckResourceAttr("data.altr_repo_users.azure", "usernames.0", username2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRepoUsersDataSource_credentialProviderValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "altr_repo_users" "test" {
+  repo_name           = "example"
+  credential_provider = "plaintext"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
@@ -0,0 +1,237 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ datasource.DataSource = &ReposDataSource{}
+
+func NewReposDataSource() datasource.DataSource {
+	return &ReposDataSource{}
+}
+
+type ReposDataSource struct {
+	client *client.Client
+}
+
+type ReposDataSourceModel struct {
+	Type      types.String               `tfsdk:"type"`
+	NameRegex types.String               `tfsdk:"name_regex"`
+	Names     []types.String             `tfsdk:"names"`
+	Repos     []ReposDataSourceRepoModel `tfsdk:"repos"`
+}
+
+type ReposDataSourceRepoModel struct {
+	Name              types.String          `tfsdk:"name"`
+	Description       types.String          `tfsdk:"description"`
+	Type              types.String          `tfsdk:"type"`
+	Hostname          types.String          `tfsdk:"hostname"`
+	Port              types.Int64           `tfsdk:"port"`
+	AccountLocator    types.String          `tfsdk:"account_locator"`
+	Warehouse         types.String          `tfsdk:"warehouse"`
+	HTTPPath          types.String          `tfsdk:"http_path"`
+	ConnectionOptions basetypes.ObjectValue `tfsdk:"connection_options"`
+	UserCount         types.Int64           `tfsdk:"user_count"`
+	ServiceUserCount  types.Int64           `tfsdk:"service_user_count"`
+	BindingCount      types.Int64           `tfsdk:"binding_count"`
+	CreatedAt         types.String          `tfsdk:"created_at"`
+	UpdatedAt         types.String          `tfsdk:"updated_at"`
+}
+
+func (d *ReposDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repos"
+}
+
+func (d *ReposDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for listing the repositories in the organization, optionally filtered. All filters that are set must match.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "Only return repositories of this type (Oracle, MSSQL, MySQL, Postgres, Snowflake, Redshift, Databricks).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(service.DatabaseTypes...),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return repositories whose name matches this RE2 regular expression.",
+				Optional:    true,
+			},
+			"names": schema.ListAttribute{
+				Description: "Names of the matching repositories, sorted.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"repos": schema.ListNestedAttribute{
+				Description: "Matching repositories, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the repository.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the repository.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the repository.",
+							Computed:    true,
+						},
+						"hostname": schema.StringAttribute{
+							Description: "Hostname of the repository.",
+							Computed:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "Port number of the repository.",
+							Computed:    true,
+						},
+						"account_locator": schema.StringAttribute{
+							Description: "Snowflake account locator. Only set for Snowflake repositories.",
+							Computed:    true,
+						},
+						"warehouse": schema.StringAttribute{
+							Description: "Snowflake virtual warehouse used for queries. Only set for Snowflake repositories.",
+							Computed:    true,
+						},
+						"http_path": schema.StringAttribute{
+							Description: "HTTP path of the Databricks SQL warehouse. Only set for Databricks repositories.",
+							Computed:    true,
+						},
+						"connection_options": connectionOptionsDataSourceAttribute(),
+						"user_count": schema.Int64Attribute{
+							Description: "Number of users associated with this repository.",
+							Computed:    true,
+						},
+						"service_user_count": schema.Int64Attribute{
+							Description: "Number of service users associated with this repository.",
+							Computed:    true,
+						},
+						"binding_count": schema.Int64Attribute{
+							Description: "Number of sidecar bindings for this repository.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Creation timestamp.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "Last update timestamp.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ReposDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ReposDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ReposDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !config.NameRegex.IsNull() {
+		var err error
+
+		nameRegex, err = regexp.Compile(config.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Configuration",
+				"name_regex is not a valid regular expression: "+err.Error(),
+			)
+
+			return
+		}
+	}
+
+	// Get repos from API
+	repos, err := d.client.ListRepos()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing repositories",
+			"Could not list repositories, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	sort.Slice(repos, func(i, j int) bool {
+		return repos[i].Name < repos[j].Name
+	})
+
+	config.Names = []types.String{}
+	config.Repos = []ReposDataSourceRepoModel{}
+
+	for _, repo := range repos {
+		if !config.Type.IsNull() && repo.Type != config.Type.ValueString() {
+			continue
+		}
+
+		if nameRegex != nil && !nameRegex.MatchString(repo.Name) {
+			continue
+		}
+
+		config.Names = append(config.Names, types.StringValue(repo.Name))
+		config.Repos = append(config.Repos, ReposDataSourceRepoModel{
+			Name:              types.StringValue(repo.Name),
+			Description:       types.StringValue(repo.Description),
+			Type:              types.StringValue(repo.Type),
+			Hostname:          types.StringValue(repo.Hostname),
+			Port:              types.Int64Value(int64(repo.Port)),
+			AccountLocator:    optionalStringValue(repo.AccountLocator),
+			Warehouse:         optionalStringValue(repo.Warehouse),
+			HTTPPath:          optionalStringValue(repo.HTTPPath),
+			ConnectionOptions: connectionOptionsToObject(repo.ConnectionOptions),
+			UserCount:         types.Int64Value(int64(repo.UserCount)),
+			ServiceUserCount:  types.Int64Value(int64(repo.ServiceUserCount)),
+			BindingCount:      types.Int64Value(int64(repo.BindingCount)),
+			CreatedAt:         types.StringValue(repo.CreatedAt),
+			UpdatedAt:         types.StringValue(repo.UpdatedAt),
+		})
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package repo_test
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
+	"github.com/google/uuid"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccReposDataSource_basic(t *testing.T) {
+	resourceName := "altr_repo.test"
+	rName := fmt.Sprintf("repo_%d", rand.Int())
+	rHostname := fmt.Sprintf("%s.example.altr.com", uuid.New().String())
+	port := sdkacctest.RandIntRange(1, 65535)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRepoDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepoResourceConfig_basic(rName, "Postgres", rHostname, port) + `
+data "altr_repos" "by_regex" {
+  name_regex = "^${altr_repo.test.name}$"
+}
+
+data "altr_repos" "by_type" {
+  type       = altr_repo.test.type
+  name_regex = "^${altr_repo.test.name}$"
+}
+
+data "altr_repos" "other_type" {
+  type       = "Oracle"
+  name_regex = "^${altr_repo.test.name}$"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.altr_repos.by_regex", "names.#", "1"),
+					resource.TestCheckResourceAttrPair("data.altr_repos.by_regex", "names.0", resourceName, "name"),
+					resource.TestCheckResourceAttrPair("data.altr_repos.by_regex", "repos.0.hostname", resourceName, "hostname"),
+					resource.TestCheckResourceAttrPair("data.altr_repos.by_regex", "repos.0.port", resourceName, "port"),
+					resource.TestCheckResourceAttr("data.altr_repos.by_regex", "repos.0.binding_count", "0"),
+					resource.TestCheckResourceAttr("data.altr_repos.by_type", "repos.#", "1"),
+					resource.TestCheckResourceAttr("data.altr_repos.by_type", "repos.0.type", "Postgres"),
+					resource.TestCheckResourceAttr("data.altr_repos.other_type", "repos.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccReposDataSource_typeValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "altr_repos" "test" {
+  type = "DB2"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
@@ -0,0 +1,170 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sidecar
+
+import (
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Listener TLS settings control whether the sidecar terminates client TLS on a
+// listener port and with which altr_sidecar_certificate.
+
+// listenerTLSMinVersions are the accepted minimum TLS protocol versions
+var listenerTLSMinVersions = []string{"1.2", "1.3"}
+
+const defaultListenerTLSMinVersion = "1.2"
+
+var listenerTLSAttrTypes = map[string]attr.Type{
+	"enabled":        types.BoolType,
+	"required":       types.BoolType,
+	"min_version":    types.StringType,
+	"certificate_id": types.StringType,
+}
+
+func listenerTLSSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Client TLS settings of the listener. Omit to leave TLS unconfigured.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the sidecar accepts TLS connections on the listener.",
+				Required:    true,
+			},
+			"required": schema.BoolAttribute{
+				Description: "Whether clients must connect with TLS. Requires enabled. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"min_version": schema.StringAttribute{
+				Description: "Minimum TLS protocol version: 1.2 or 1.3. Defaults to 1.2.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultListenerTLSMinVersion),
+				Validators: []validator.String{
+					stringvalidator.OneOf(listenerTLSMinVersions...),
+				},
+			},
+			"certificate_id": schema.StringAttribute{
+				Description: "ID of the altr_sidecar_certificate the listener presents. Required when enabled is true.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// validateListenerTLS checks the settings of a configured tls object
+func validateListenerTLS(obj basetypes.ObjectValue, attrPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if obj.IsNull() || obj.IsUnknown() {
+		return diags
+	}
+
+	attrs := obj.Attributes()
+	enabled, _ := attrs["enabled"].(types.Bool)
+	required, _ := attrs["required"].(types.Bool)
+	certificateID, _ := attrs["certificate_id"].(types.String)
+
+	if enabled.IsUnknown() {
+		return diags
+	}
+
+	if enabled.ValueBool() && certificateID.IsNull() {
+		diags.AddAttributeError(
+			attrPath.AtName("certificate_id"),
+			"Missing Certificate",
+			"certificate_id must be set when TLS is enabled.",
+		)
+	}
+
+	if !enabled.ValueBool() && required.ValueBool() {
+		diags.AddAttributeError(
+			attrPath.AtName("required"),
+			"Invalid TLS Configuration",
+			"TLS cannot be required when it is not enabled.",
+		)
+	}
+
+	return diags
+}
+
+// listenerTLSFromObject converts the tls object into the client-side
+// representation, or nil when it is not set.
+func listenerTLSFromObject(obj basetypes.ObjectValue) *client.ListenerTLS {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+
+	attrs := obj.Attributes()
+	enabled, _ := attrs["enabled"].(types.Bool)
+	required, _ := attrs["required"].(types.Bool)
+	minVersion, _ := attrs["min_version"].(types.String)
+	certificateID, _ := attrs["certificate_id"].(types.String)
+
+	return &client.ListenerTLS{
+		Enabled:       enabled.ValueBool(),
+		Required:      required.ValueBool(),
+		MinVersion:    minVersion.ValueString(),
+		CertificateID: certificateID.ValueString(),
+	}
+}
+
+// listenerTLSToObject converts the TLS settings of the API response back into the
+// tls object, or a null object when the listener has none.
+func listenerTLSToObject(tls *client.ListenerTLS) basetypes.ObjectValue {
+	if tls == nil {
+		return basetypes.NewObjectNull(listenerTLSAttrTypes)
+	}
+
+	minVersion := tls.MinVersion
+	if minVersion == "" {
+		minVersion = defaultListenerTLSMinVersion
+	}
+
+	certificateID := types.StringNull()
+	if tls.CertificateID != "" {
+		certificateID = types.StringValue(tls.CertificateID)
+	}
+
+	return basetypes.NewObjectValueMust(listenerTLSAttrTypes, map[string]attr.Value{
+		"enabled":        types.BoolValue(tls.Enabled),
+		"required":       types.BoolValue(tls.Required),
+		"min_version":    types.StringValue(minVersion),
+		"certificate_id": certificateID,
+	})
+}
+
+// sameListenerTLS reports whether two TLS settings are equivalent, treating an
+// unset minimum version as the default
+func sameListenerTLS(a, b *client.ListenerTLS) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	x, y := *a, *b
+
+	if x.MinVersion == "" {
+		x.MinVersion = defaultListenerTLSMinVersion
+	}
+
+	if y.MinVersion == "" {
+		y.MinVersion = defaultListenerTLSMinVersion
+	}
+
+	return x == y
+}
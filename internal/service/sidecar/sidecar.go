@@ -7,10 +7,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"regexp"
 
 	"github.com/altrsoftware/terraform-provider-altr/internal/client"
 	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/altrsoftware/terraform-provider-altr/internal/validation"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -35,18 +35,23 @@ type SidecarResource struct {
 }
 
 type SidecarResourceModel struct {
-	ID                       types.String `tfsdk:"id"`
-	Name                     types.String `tfsdk:"name"`
-	Description              types.String `tfsdk:"description"`
-	Hostname                 types.String `tfsdk:"hostname"`
-	PublicKey1               types.String `tfsdk:"public_key_1"`
-	PublicKey2               types.String `tfsdk:"public_key_2"`
-	UnsupportedQueryBypass   types.Bool   `tfsdk:"unsupported_query_bypass"`
-	DataPlaneURL             types.String `tfsdk:"data_plane_url"`
-	ListenerCount            types.Int64  `tfsdk:"listener_count"`
-	ListenerRepoBindingCount types.Int64  `tfsdk:"listener_repo_binding_count"`
-	CreatedAt                types.String `tfsdk:"created_at"`
-	UpdatedAt                types.String `tfsdk:"updated_at"`
+	ID                       types.String           `tfsdk:"id"`
+	Name                     types.String           `tfsdk:"name"`
+	Description              types.String           `tfsdk:"description"`
+	Hostname                 service.HostValue      `tfsdk:"hostname"`
+	PublicKey1               service.PublicKeyValue `tfsdk:"public_key_1"`
+	PublicKey2               service.PublicKeyValue `tfsdk:"public_key_2"`
+	PublicKey1WO             types.String           `tfsdk:"public_key_1_wo"`
+	PublicKey2WO             types.String           `tfsdk:"public_key_2_wo"`
+	PublicKeyWOVersion       types.Int64            `tfsdk:"public_key_wo_version"`
+	PublicKey1Fingerprint    types.String           `tfsdk:"public_key_1_fingerprint"`
+	PublicKey2Fingerprint    types.String           `tfsdk:"public_key_2_fingerprint"`
+	UnsupportedQueryBypass   types.Bool             `tfsdk:"unsupported_query_bypass"`
+	DataPlaneURL             types.String           `tfsdk:"data_plane_url"`
+	ListenerCount            types.Int64            `tfsdk:"listener_count"`
+	ListenerRepoBindingCount types.Int64            `tfsdk:"listener_repo_binding_count"`
+	CreatedAt                types.String           `tfsdk:"created_at"`
+	UpdatedAt                types.String           `tfsdk:"updated_at"`
 }
 
 func (r *SidecarResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -80,25 +85,67 @@ func (r *SidecarResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"hostname": schema.StringAttribute{
-				Description: "Hostname of the sidecar.",
-				Required:    true,
+				Description: "Hostname or IP address of the sidecar. Accepts RFC 1123 hostnames, IPv4 addresses and IPv6 addresses with or without brackets; " +
+					"differences in hostname case, a trailing dot or the IP address spelling are ignored.",
+				CustomType: service.HostType{},
+				Required:   true,
 				Validators: []validator.String{
-					stringvalidator.LengthBetween(1, 500),
-					stringvalidator.RegexMatches(
-						regexp.MustCompile(service.HostnameRegexStringRFC1123),
-						"must be a valid hostname",
-					),
+					validation.Host(),
 				},
 			},
 			"public_key_1": schema.StringAttribute{
-				Description: "First public key for the sidecar.",
+				Description: "First public key for the sidecar, as a PEM-encoded PKIX RSA public key of at least 2048 bits. Differences in whitespace or line wrapping are ignored.",
+				CustomType:  service.PublicKeyType{},
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					validation.RSAPublicKey(),
+				},
 			},
 			"public_key_2": schema.StringAttribute{
-				Description: "Second public key for the sidecar.",
+				Description: "Second public key for the sidecar, as a PEM-encoded PKIX RSA public key of at least 2048 bits. Differences in whitespace or line wrapping are ignored.",
+				CustomType:  service.PublicKeyType{},
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					validation.RSAPublicKey(),
+				},
+			},
+			"public_key_1_wo": schema.StringAttribute{
+				Description: "Write-only alternative to public_key_1 that accepts ephemeral values, such as altr_registration_keypair.public_key_pem. Only sent when the sidecar is created or public_key_wo_version changes. Requires Terraform 1.11 or later.",
+				Optional:    true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("public_key_1")),
+					validation.RSAPublicKey(),
+				},
+			},
+			"public_key_2_wo": schema.StringAttribute{
+				Description: "Write-only alternative to public_key_2 that accepts ephemeral values. Only sent when the sidecar is created or public_key_wo_version changes. Requires Terraform 1.11 or later.",
+				Optional:    true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("public_key_2")),
+					validation.RSAPublicKey(),
+				},
+			},
+			"public_key_wo_version": schema.Int64Attribute{
+				Description: "Version of the write-only public keys. Change this value to send new public_key_1_wo/public_key_2_wo values to ALTR.",
+				Optional:    true,
+			},
+			"public_key_1_fingerprint": schema.StringAttribute{
+				Description: "SHA-256 fingerprint of public_key_1, as the lowercase hex digest of the DER-encoded key (openssl pkey -pubin -outform DER | sha256sum).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					service.PublicKeyFingerprintFrom("public_key_1"),
+				},
+			},
+			"public_key_2_fingerprint": schema.StringAttribute{
+				Description: "SHA-256 fingerprint of public_key_2, as the lowercase hex digest of the DER-encoded key (openssl pkey -pubin -outform DER | sha256sum).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					service.PublicKeyFingerprintFrom("public_key_2"),
+				},
 			},
 			"unsupported_query_bypass": schema.BoolAttribute{
 				Description: "When true, unsupported queries will bypass the query parser and return all results without applying policy instead of returning an error.",
@@ -129,10 +176,11 @@ func (r *SidecarResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
-// Helper function to validate public key requirements
-func (r *SidecarResource) validatePublicKeys(model *SidecarResourceModel) error {
-	hasPublicKey1 := !model.PublicKey1.IsNull() && !model.PublicKey1.IsUnknown() && model.PublicKey1.ValueString() != ""
-	hasPublicKey2 := !model.PublicKey2.IsNull() && !model.PublicKey2.IsUnknown() && model.PublicKey2.ValueString() != ""
+// Helper function to validate public key requirements against the configuration,
+// which is the only place write-only keys are visible
+func (r *SidecarResource) validatePublicKeys(config *SidecarResourceModel) error {
+	hasPublicKey1 := hasValue(config.PublicKey1.StringValue) || hasValue(config.PublicKey1WO)
+	hasPublicKey2 := hasValue(config.PublicKey2.StringValue) || hasValue(config.PublicKey2WO)
 
 	if !hasPublicKey1 && !hasPublicKey2 {
 		return errors.New("at least one of 'public_key_1' or 'public_key_2' must be specified")
@@ -141,6 +189,10 @@ func (r *SidecarResource) validatePublicKeys(model *SidecarResourceModel) error
 	return nil
 }
 
+func hasValue(value types.String) bool {
+	return !value.IsNull() && !value.IsUnknown() && value.ValueString() != ""
+}
+
 func (r *SidecarResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -160,16 +212,17 @@ func (r *SidecarResource) Configure(ctx context.Context, req resource.ConfigureR
 }
 
 func (r *SidecarResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan SidecarResourceModel
+	var plan, config SidecarResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Validate that at least one public key is provided
-	if err := r.validatePublicKeys(&plan); err != nil {
+	if err := r.validatePublicKeys(&config); err != nil {
 		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
 
 		return
@@ -178,13 +231,21 @@ func (r *SidecarResource) Create(ctx context.Context, req resource.CreateRequest
 	// Create the input for the API call
 	input := client.CreateSidecarInput{
 		Name:                   plan.Name.ValueString(),
-		Hostname:               plan.Hostname.ValueString(),
+		Hostname:               plan.Hostname.NormalizedHost(),
 		Description:            plan.Description.ValueString(),
 		PublicKey1:             plan.PublicKey1.ValueString(),
 		PublicKey2:             plan.PublicKey2.ValueString(),
 		UnsupportedQueryBypass: plan.UnsupportedQueryBypass.ValueBool(),
 	}
 
+	if hasValue(config.PublicKey1WO) {
+		input.PublicKey1 = config.PublicKey1WO.ValueString()
+	}
+
+	if hasValue(config.PublicKey2WO) {
+		input.PublicKey2 = config.PublicKey2WO.ValueString()
+	}
+
 	// Call the API to create the sidecar
 	sidecar, err := r.client.CreateSidecar(input)
 	if err != nil {
@@ -239,19 +300,21 @@ func (r *SidecarResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 func (r *SidecarResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var (
-		plan  SidecarResourceModel
-		state SidecarResourceModel
+		plan   SidecarResourceModel
+		state  SidecarResourceModel
+		config SidecarResourceModel
 	)
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Validate that at least one public key is provided
-	if err := r.validatePublicKeys(&plan); err != nil {
+	if err := r.validatePublicKeys(&config); err != nil {
 		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
 
 		return
@@ -270,17 +333,30 @@ func (r *SidecarResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	if !plan.Hostname.Equal(state.Hostname) {
-		input.Hostname = plan.Hostname.ValueStringPointer()
+		hostname := plan.Hostname.NormalizedHost()
+		input.Hostname = &hostname
 	}
 
-	if !plan.PublicKey1.Equal(state.PublicKey1) {
+	// Keys that are not configured are planned as unknown and must not be sent
+	if !plan.PublicKey1.IsUnknown() && !plan.PublicKey1.Equal(state.PublicKey1) {
 		input.PublicKey1 = plan.PublicKey1.ValueStringPointer()
 	}
 
-	if !plan.PublicKey2.Equal(state.PublicKey2) {
+	if !plan.PublicKey2.IsUnknown() && !plan.PublicKey2.Equal(state.PublicKey2) {
 		input.PublicKey2 = plan.PublicKey2.ValueStringPointer()
 	}
 
+	// Write-only keys are never stored, so they are only re-sent when their version changes
+	if !plan.PublicKeyWOVersion.Equal(state.PublicKeyWOVersion) {
+		if hasValue(config.PublicKey1WO) {
+			input.PublicKey1 = config.PublicKey1WO.ValueStringPointer()
+		}
+
+		if hasValue(config.PublicKey2WO) {
+			input.PublicKey2 = config.PublicKey2WO.ValueStringPointer()
+		}
+	}
+
 	if !plan.UnsupportedQueryBypass.Equal(state.UnsupportedQueryBypass) {
 		input.UnsupportedQueryBypass = plan.UnsupportedQueryBypass.ValueBoolPointer()
 	}
@@ -334,7 +410,7 @@ func (r *SidecarResource) mapSidecarToModel(sidecar *client.Sidecar, model *Side
 	model.ID = types.StringValue(sidecar.ID)
 	model.Name = types.StringValue(sidecar.Name)
 	model.Description = types.StringValue(sidecar.Description)
-	model.Hostname = types.StringValue(sidecar.Hostname)
+	model.Hostname = service.NewHostValue(sidecar.Hostname)
 	model.DataPlaneURL = types.StringValue(sidecar.DataPlaneURL)
 	model.ListenerCount = types.Int64Value(int64(sidecar.ListenerCount))
 	model.ListenerRepoBindingCount = types.Int64Value(int64(sidecar.ListenerRepoBindingCount))
@@ -344,14 +420,17 @@ func (r *SidecarResource) mapSidecarToModel(sidecar *client.Sidecar, model *Side
 
 	// Handle public keys - only set if they exist and contain data
 	if sidecar.PublicKey1 != nil && sidecar.PublicKey1.RSAKey != "" {
-		model.PublicKey1 = types.StringValue(sidecar.PublicKey1.RSAKey)
+		model.PublicKey1 = service.NewPublicKeyValue(sidecar.PublicKey1.RSAKey)
 	} else {
-		model.PublicKey1 = types.StringNull()
+		model.PublicKey1 = service.NewPublicKeyNull()
 	}
 
 	if sidecar.PublicKey2 != nil && sidecar.PublicKey2.RSAKey != "" {
-		model.PublicKey2 = types.StringValue(sidecar.PublicKey2.RSAKey)
+		model.PublicKey2 = service.NewPublicKeyValue(sidecar.PublicKey2.RSAKey)
 	} else {
-		model.PublicKey2 = types.StringNull()
+		model.PublicKey2 = service.NewPublicKeyNull()
 	}
+
+	model.PublicKey1Fingerprint = service.PublicKeyFingerprintValue(model.PublicKey1)
+	model.PublicKey2Fingerprint = service.PublicKeyFingerprintValue(model.PublicKey2)
 }
@@ -0,0 +1,337 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sidecar
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/altrsoftware/terraform-provider-altr/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &SidecarCertificateResource{}
+	_ resource.ResourceWithImportState    = &SidecarCertificateResource{}
+	_ resource.ResourceWithValidateConfig = &SidecarCertificateResource{}
+)
+
+func NewSidecarCertificateResource() resource.Resource {
+	return &SidecarCertificateResource{}
+}
+
+type SidecarCertificateResource struct {
+	client *client.Client
+}
+
+type SidecarCertificateResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	SidecarID        types.String `tfsdk:"sidecar_id"`
+	Name             types.String `tfsdk:"name"`
+	CertificateChain types.String `tfsdk:"certificate_chain"`
+	PrivateKeyWO     types.String `tfsdk:"private_key_wo"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
+}
+
+func (r *SidecarCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sidecar_certificate"
+}
+
+func (r *SidecarCertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a TLS certificate the sidecar presents to database clients on listeners that reference it. " +
+			"Changing the certificate chain rotates the certificate in place, so referencing listeners are not re-registered.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sidecar_id": schema.StringAttribute{
+				Description: "ID of the sidecar the certificate is uploaded to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(service.UUIDv4Regex),
+						"must be a valid UUIDv4",
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the certificate.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"certificate_chain": schema.StringAttribute{
+				Description: "PEM-encoded certificate chain, leaf certificate first followed by any intermediate certificates.",
+				Required:    true,
+				Validators: []validator.String{
+					validation.CertificateChain(),
+				},
+			},
+			"private_key_wo": schema.StringAttribute{
+				Description: "PEM-encoded private key of the leaf certificate. Write-only: it is sent whenever the certificate is " +
+					"created or updated and never stored in state. Requires Terraform 1.11 or later.",
+				Required:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Expiry timestamp of the leaf certificate.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Last update timestamp.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *SidecarCertificateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SidecarCertificateResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.CertificateChain.IsNull() || config.CertificateChain.IsUnknown() || config.PrivateKeyWO.IsNull() || config.PrivateKeyWO.IsUnknown() {
+		return
+	}
+
+	// An unparsable chain is already reported by the certificate_chain validator
+	if _, err := validation.ParseCertificateChain(config.CertificateChain.ValueString()); err != nil {
+		return
+	}
+
+	if err := validation.CertificateKeyPair(config.CertificateChain.ValueString(), config.PrivateKeyWO.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("private_key_wo"),
+			"Invalid Private Key",
+			err.Error(),
+		)
+	}
+}
+
+func (r *SidecarCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SidecarCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config SidecarCertificateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Write-only values are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificate, err := r.client.CreateSidecarCertificate(plan.SidecarID.ValueString(), client.SidecarCertificateInput{
+		Name:             plan.Name.ValueString(),
+		CertificateChain: plan.CertificateChain.ValueString(),
+		PrivateKey:       config.PrivateKeyWO.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating sidecar certificate",
+			"Could not create sidecar certificate, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Map response to the model
+	r.mapCertificateToModel(certificate, &plan)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *SidecarCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SidecarCertificateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificate, err := r.client.GetSidecarCertificate(state.SidecarID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading sidecar certificate",
+			"Could not read sidecar certificate "+state.ID.ValueString()+": "+err.Error(),
+		)
+
+		return
+	}
+
+	// If certificate doesn't exist, remove it from state
+	if certificate == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// Map response to the model
+	r.mapCertificateToModel(certificate, &state)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *SidecarCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state, config SidecarCertificateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The certificate is replaced as a whole, so the key is always sent again
+	certificate, err := r.client.UpdateSidecarCertificate(state.SidecarID.ValueString(), state.ID.ValueString(), client.SidecarCertificateInput{
+		Name:             plan.Name.ValueString(),
+		CertificateChain: plan.CertificateChain.ValueString(),
+		PrivateKey:       config.PrivateKeyWO.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating sidecar certificate",
+			"Could not update sidecar certificate, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Map response to the model
+	r.mapCertificateToModel(certificate, &plan)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *SidecarCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SidecarCertificateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSidecarCertificate(state.SidecarID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting sidecar certificate",
+			"Could not delete sidecar certificate, unexpected error: "+err.Error()+
+				". A certificate cannot be deleted while listeners reference it.",
+		)
+
+		return
+	}
+}
+
+func (r *SidecarCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expected import ID format: "sidecar_id:certificate_id"
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected import ID in format: sidecar_id:certificate_id",
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sidecar_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+// Helper function to map API response to Terraform model
+func (r *SidecarCertificateResource) mapCertificateToModel(certificate *client.SidecarCertificate, model *SidecarCertificateResourceModel) {
+	model.ID = types.StringValue(certificate.ID)
+	model.SidecarID = types.StringValue(certificate.SidecarID)
+	model.Name = types.StringValue(certificate.Name)
+	model.ExpiresAt = types.StringValue(certificate.NotAfter)
+	model.CreatedAt = types.StringValue(certificate.CreatedAt)
+	model.UpdatedAt = types.StringValue(certificate.UpdatedAt)
+
+	// Keep the configured PEM text when the API returns the same certificates in a different layout
+	if model.CertificateChain.IsNull() || !sameCertificateChain(model.CertificateChain.ValueString(), certificate.CertificateChain) {
+		model.CertificateChain = types.StringValue(certificate.CertificateChain)
+	}
+
+	// Write-only values are never stored in state
+	model.PrivateKeyWO = types.StringNull()
+}
+
+// sameCertificateChain reports whether two PEM chains hold the same certificates in the same order
+func sameCertificateChain(a, b string) bool {
+	chainA, err := validation.ParseCertificateChain(a)
+	if err != nil {
+		return false
+	}
+
+	chainB, err := validation.ParseCertificateChain(b)
+	if err != nil || len(chainA) != len(chainB) {
+		return false
+	}
+
+	for i := range chainA {
+		if !bytes.Equal(chainA[i].Raw, chainB[i].Raw) {
+			return false
+		}
+	}
+
+	return true
+}
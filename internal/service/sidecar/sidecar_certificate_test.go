@@ -0,0 +1,217 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sidecar_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSidecarCertificateResource_basic(t *testing.T) {
+	resourceName := "altr_sidecar_certificate.test"
+	sidecarResourceName := "altr_sidecar.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	rHostname := fmt.Sprintf("%s.example.altr.com", rName)
+	certificate1, key1 := testAccSidecarCertificatePEM(t, rHostname)
+	certificate2, key2 := testAccSidecarCertificatePEM(t, rHostname)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSidecarCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSidecarCertificateResourceConfig_basic(rName, rHostname, pubKeyExample1, certificate1, key1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSidecarCertificateExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "sidecar_id", sidecarResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "certificate_chain", certificate1),
+					resource.TestCheckNoResourceAttr(resourceName, "private_key_wo"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires_at"),
+				),
+			},
+			{
+				// Rotating the certificate keeps its ID
+				Config: testAccSidecarCertificateResourceConfig_basic(rName, rHostname, pubKeyExample1, certificate2, key2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSidecarCertificateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "certificate_chain", certificate2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceName]
+
+					return fmt.Sprintf("%s:%s", rs.Primary.Attributes["sidecar_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccSidecarCertificateResource_keyMismatch(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	rHostname := fmt.Sprintf("%s.example.altr.com", rName)
+	certificate, _ := testAccSidecarCertificatePEM(t, rHostname)
+	_, otherKey := testAccSidecarCertificatePEM(t, rHostname)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSidecarCertificateResourceConfig_basic(rName, rHostname, pubKeyExample1, certificate, otherKey),
+				ExpectError: regexp.MustCompile(`Invalid Private Key`),
+			},
+			{
+				Config:      testAccSidecarCertificateResourceConfig_basic(rName, rHostname, pubKeyExample1, "not a certificate", otherKey),
+				ExpectError: regexp.MustCompile(`Invalid Certificate Chain`),
+			},
+		},
+	})
+}
+
+// testAccSidecarCertificatePEM returns a self-signed PEM certificate for hostname and its PEM private key
+func testAccSidecarCertificatePEM(t *testing.T, hostname string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %s", err)
+	}
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatalf("generating serial number: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: hostname},
+		DNSNames:     []string{hostname},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %s", err)
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshaling key: %s", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}))
+}
+
+func testAccCheckSidecarCertificateExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Sidecar Certificate ID is set")
+		}
+
+		conn, err := client.NewClient(
+			testGetEnv("ALTR_ORG_ID", "test-org"),
+			testGetEnv("ALTR_API_KEY", "test-key"),
+			testGetEnv("ALTR_SECRET", "test-secret"),
+			testGetEnv("ALTR_BASE_URL", ""),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create test client: %w", err)
+		}
+
+		certificate, err := conn.GetSidecarCertificate(rs.Primary.Attributes["sidecar_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if certificate == nil {
+			return fmt.Errorf("Sidecar Certificate not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSidecarCertificateDestroy(s *terraform.State) error {
+	conn, err := client.NewClient(
+		testGetEnv("ALTR_ORG_ID", "test-org"),
+		testGetEnv("ALTR_API_KEY", "test-key"),
+		testGetEnv("ALTR_SECRET", "test-secret"),
+		testGetEnv("ALTR_BASE_URL", ""),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create test client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "altr_sidecar_certificate" {
+			continue
+		}
+
+		certificate, err := conn.GetSidecarCertificate(rs.Primary.Attributes["sidecar_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if certificate != nil {
+			return fmt.Errorf("Sidecar Certificate %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccSidecarCertificateResourceConfig_basic(name, hostname, publicKey1, certificateChain, privateKey string) string {
+	return fmt.Sprintf(`
+resource "altr_sidecar" "test" {
+  name         = %[1]q
+  hostname     = %[2]q
+  public_key_1 = %[3]q
+}
+
+resource "altr_sidecar_certificate" "test" {
+  sidecar_id        = altr_sidecar.test.id
+  name              = %[1]q
+  certificate_chain = %[4]q
+  private_key_wo    = %[5]q
+}
+`, name, hostname, publicKey1, certificateChain, privateKey)
+}
@@ -7,17 +7,23 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/altrsoftware/terraform-provider-altr/internal/client"
 	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var _ datasource.DataSource = &SidecarDataSource{}
+var (
+	_ datasource.DataSource                     = &SidecarDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &SidecarDataSource{}
+)
 
 func NewSidecarDataSource() datasource.DataSource {
 	return &SidecarDataSource{}
@@ -52,8 +58,9 @@ func (d *SidecarDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 		Description: "Data source for retrieving sidecar information.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "ID of the sidecar.",
-				Required:    true,
+				Description: "ID of the sidecar. Exactly one of id or name must be specified.",
+				Optional:    true,
+				Computed:    true,
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(
 						regexp.MustCompile(service.UUIDv4Regex),
@@ -62,8 +69,12 @@ func (d *SidecarDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "Name of the sidecar.",
+				Description: "Name of the sidecar. Exactly one of id or name must be specified; the name must match exactly one sidecar.",
+				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
 			},
 			"description": schema.StringAttribute{
 				Description: "Description of the sidecar.",
@@ -113,6 +124,15 @@ func (d *SidecarDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 	}
 }
 
+func (d *SidecarDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d *SidecarDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -140,25 +160,68 @@ func (d *SidecarDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	// Get sidecar from API
-	sidecar, err := d.client.GetSidecar(config.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading sidecar",
-			"Could not read sidecar "+config.ID.ValueString()+": "+err.Error(),
-		)
+	var sidecar *client.Sidecar
 
-		return
-	}
+	if !config.Name.IsNull() {
+		// Resolve the name through the list API
+		sidecars, err := d.client.ListSidecars()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading sidecar",
+				"Could not list sidecars, unexpected error: "+err.Error(),
+			)
 
-	// If sidecar doesn't exist, return error
-	if sidecar == nil {
-		resp.Diagnostics.AddError(
-			"Sidecar not found",
-			"Sidecar with ID '"+config.ID.ValueString()+"' does not exist.",
-		)
+			return
+		}
 
-		return
+		var matches []string
+		for i := range sidecars {
+			if sidecars[i].Name == config.Name.ValueString() {
+				sidecar = &sidecars[i]
+				matches = append(matches, sidecars[i].ID)
+			}
+		}
+
+		if len(matches) == 0 {
+			resp.Diagnostics.AddError(
+				"Sidecar not found",
+				"No sidecar named '"+config.Name.ValueString()+"' exists.",
+			)
+
+			return
+		}
+
+		if len(matches) > 1 {
+			resp.Diagnostics.AddError(
+				"Multiple sidecars found",
+				fmt.Sprintf("%d sidecars are named '%s' (IDs: %s). Look the sidecar up by id instead.", len(matches), config.Name.ValueString(), strings.Join(matches, ", ")),
+			)
+
+			return
+		}
+	} else {
+		var err error
+
+		// Get sidecar from API
+		sidecar, err = d.client.GetSidecar(config.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading sidecar",
+				"Could not read sidecar "+config.ID.ValueString()+": "+err.Error(),
+			)
+
+			return
+		}
+
+		// If sidecar doesn't exist, return error
+		if sidecar == nil {
+			resp.Diagnostics.AddError(
+				"Sidecar not found",
+				"Sidecar with ID '"+config.ID.ValueString()+"' does not exist.",
+			)
+
+			return
+		}
 	}
 
 	// Map response to the model
@@ -0,0 +1,85 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sidecar_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSidecarDataSource_byName(t *testing.T) {
+	resourceName := "altr_sidecar.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	rHostname := fmt.Sprintf("%s.example.altr.com", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSidecarDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSidecarResourceConfig_basic(rName, rHostname, pubKeyExample1) + `
+data "altr_sidecar" "by_id" {
+  id = altr_sidecar.test.id
+}
+
+data "altr_sidecar" "by_name" {
+  name = altr_sidecar.test.name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.altr_sidecar.by_id", "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair("data.altr_sidecar.by_name", "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair("data.altr_sidecar.by_name", "hostname", resourceName, "hostname"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSidecarDataSource_lookupValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "altr_sidecar" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Exactly one of these attributes must be configured: \[id,name\]`),
+			},
+			{
+				Config: `
+data "altr_sidecar" "test" {
+  id   = "682bfaa1-77e1-40aa-897f-1a0469f4ac64"
+  name = "example"
+}
+`,
+				ExpectError: regexp.MustCompile(`Exactly one of these attributes must be configured: \[id,name\]`),
+			},
+		},
+	})
+}
+
+func TestAccSidecarDataSource_nameNotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "altr_sidecar" "test" {
+  name = %q
+}
+`, sdkacctest.RandomWithPrefix("tf-acc-test-missing")),
+				ExpectError: regexp.MustCompile(`No sidecar named`),
+			},
+		},
+	})
+}
@@ -0,0 +1,42 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sidecar
+
+import (
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewSidecarKeyRotationResource() resource.Resource {
+	return service.NewKeyRotationResource("sidecar", "a sidecar", func(c *client.Client) service.PublicKeySlotClient { return sidecarKeySlots{c} })
+}
+
+// sidecarKeySlots exposes the key slots of a sidecar to the shared rotation logic
+type sidecarKeySlots struct {
+	client *client.Client
+}
+
+func (k sidecarKeySlots) GetPublicKeySlots(id string) (*service.PublicKeySlots, error) {
+	sidecar, err := k.client.GetSidecar(id)
+	if err != nil || sidecar == nil {
+		return nil, err
+	}
+
+	return &service.PublicKeySlots{ID: sidecar.ID, Key1: sidecar.PublicKey1, Key2: sidecar.PublicKey2}, nil
+}
+
+func (k sidecarKeySlots) UpdatePublicKeySlot(id string, slot int64, value string) (*service.PublicKeySlots, error) {
+	input := client.UpdateSidecarInput{PublicKey1: &value}
+	if slot == 2 {
+		input = client.UpdateSidecarInput{PublicKey2: &value}
+	}
+
+	sidecar, err := k.client.UpdateSidecar(id, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &service.PublicKeySlots{ID: sidecar.ID, Key1: sidecar.PublicKey1, Key2: sidecar.PublicKey2}, nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sidecar_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// pubKeyExample2Fingerprint is the SHA-256 digest of the DER encoding of pubKeyExample2
+const pubKeyExample2Fingerprint = "92e82a9afd42c71d5419a0f2a58e584f68f36800fe3625ae2955d2cc9703ea5a"
+
+func TestAccSidecarKeyRotationResource_basic(t *testing.T) {
+	resourceName := "altr_sidecar_key_rotation.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	rHostname := fmt.Sprintf("%s.example.altr.com", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSidecarDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The new key is registered next to the existing one
+				Config: testAccSidecarKeyRotationResourceConfig(rName, rHostname, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "sidecar_id", "altr_sidecar.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "active_slot", "2"),
+					resource.TestCheckResourceAttr(resourceName, "previous_slot", "1"),
+					resource.TestCheckResourceAttr(resourceName, "public_key_fingerprint", pubKeyExample2Fingerprint),
+					resource.TestCheckResourceAttrSet(resourceName, "registered_at"),
+				),
+			},
+			{
+				// Confirming the new key retires the previous one
+				Config: testAccSidecarKeyRotationResourceConfig(rName, rHostname, pubKeyExample2Fingerprint),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "active_slot", "2"),
+					resource.TestCheckNoResourceAttr(resourceName, "previous_slot"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"confirmed_fingerprint"},
+			},
+		},
+	})
+}
+
+func testAccSidecarKeyRotationResourceConfig(name, hostname, confirmedFingerprint string) string {
+	confirmed := ""
+	if confirmedFingerprint != "" {
+		confirmed = fmt.Sprintf("confirmed_fingerprint = %q", confirmedFingerprint)
+	}
+
+	return fmt.Sprintf(`
+resource "altr_sidecar" "test" {
+  name         = %[1]q
+  hostname     = %[2]q
+  public_key_1 = %[3]q
+
+  lifecycle {
+    ignore_changes = [public_key_1, public_key_2]
+  }
+}
+
+resource "altr_sidecar_key_rotation" "test" {
+  sidecar_id = altr_sidecar.test.id
+  public_key = %[4]q
+  %[5]s
+}
+`, name, hostname, pubKeyExample1, pubKeyExample2, confirmed)
+}
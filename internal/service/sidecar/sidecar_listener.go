@@ -12,6 +12,7 @@ import (
 
 	"github.com/altrsoftware/terraform-provider-altr/internal/client"
 	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/altrsoftware/terraform-provider-altr/internal/validation"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -25,8 +26,9 @@ import (
 )
 
 var (
-	_ resource.Resource                = &SidecarListenerResource{}
-	_ resource.ResourceWithImportState = &SidecarListenerResource{}
+	_ resource.Resource                   = &SidecarListenerResource{}
+	_ resource.ResourceWithImportState    = &SidecarListenerResource{}
+	_ resource.ResourceWithValidateConfig = &SidecarListenerResource{}
 )
 
 func NewSidecarListenerResource() resource.Resource {
@@ -43,6 +45,7 @@ type SidecarListenerResourceModel struct {
 	Port              types.Int64  `tfsdk:"port"`
 	DatabaseType      types.String `tfsdk:"database_type"`
 	AdvertisedVersion types.String `tfsdk:"advertised_version"`
+	TLS               types.Object `tfsdk:"tls"`
 }
 
 func (r *SidecarListenerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -75,9 +78,11 @@ func (r *SidecarListenerResource) Schema(ctx context.Context, req resource.Schem
 			},
 			// 1 to 65535
 			"port": schema.Int64Attribute{
-				Description: "Port number for the listener.",
-				Required:    true,
+				Description: "Port number for the listener. Defaults to the well-known port of the database type.",
+				Optional:    true,
+				Computed:    true,
 				PlanModifiers: []planmodifier.Int64{
+					service.DefaultPortForType("database_type"),
 					int64planmodifier.RequiresReplace(),
 				},
 				Validators: []validator.Int64{
@@ -85,29 +90,52 @@ func (r *SidecarListenerResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"database_type": schema.StringAttribute{
-				Description: "Type of database (e.g., Oracle, etc.).",
+				Description: "Type of database: Oracle, MSSQL, MySQL, Postgres, Snowflake, Redshift or Databricks.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					stringvalidator.OneOf(service.OltpDatabaseTypes...),
+					stringvalidator.OneOf(service.DatabaseTypes...),
 				},
 			},
 			"advertised_version": schema.StringAttribute{
-				Description: "Advertised version of the database.",
-				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Description: "Advertised version of the database in the format the database type reports, e.g. 19.0.0.0 for Oracle " +
+					"or 16.2 for Postgres. Changing it updates the listener in place without deregistering the port.",
+				Required: true,
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 128),
 				},
 			},
+			"tls": listenerTLSSchemaAttribute(),
 		},
 	}
 }
 
+func (r *SidecarListenerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SidecarListenerResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateListenerTLS(config.TLS, path.Root("tls"))...)
+
+	if config.DatabaseType.IsNull() || config.DatabaseType.IsUnknown() || config.AdvertisedVersion.IsNull() || config.AdvertisedVersion.IsUnknown() {
+		return
+	}
+
+	if err := validation.AdvertisedVersion(config.DatabaseType.ValueString(), config.AdvertisedVersion.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("advertised_version"),
+			"Invalid Advertised Version",
+			err.Error(),
+		)
+	}
+}
+
 func (r *SidecarListenerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -135,6 +163,11 @@ func (r *SidecarListenerResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	// The port defaults from the type, which may only have become known during apply
+	if plan.Port.IsUnknown() {
+		plan.Port = types.Int64Value(service.DefaultDatabasePorts[plan.DatabaseType.ValueString()])
+	}
+
 	// Create the input for the API call
 	input := client.RegisterSidecarListenerInput{
 		Port:         int(plan.Port.ValueInt64()),
@@ -146,6 +179,8 @@ func (r *SidecarListenerResource) Create(ctx context.Context, req resource.Creat
 		input.AdvertisedVersion = plan.AdvertisedVersion.ValueString()
 	}
 
+	input.TLS = listenerTLSFromObject(plan.TLS)
+
 	// Call the API to register the sidecar listener
 	err := r.client.RegisterSidecarListener(plan.SidecarID.ValueString(), input)
 	if err != nil {
@@ -199,12 +234,30 @@ func (r *SidecarListenerResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *SidecarListenerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// According to the API specification, sidecar listeners cannot be updated
-	// They can only be registered (created) and deregistered (deleted)
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"Sidecar listeners cannot be updated. Please recreate the resource to make changes.",
-	)
+	var plan SidecarListenerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only advertised_version and tls can change in place; everything else requires replacement
+	err := r.client.UpdateSidecarListener(plan.SidecarID.ValueString(), int(plan.Port.ValueInt64()), client.UpdateSidecarListenerInput{
+		AdvertisedVersion: plan.AdvertisedVersion.ValueString(),
+		TLS:               listenerTLSFromObject(plan.TLS),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating sidecar listener",
+			"Could not update sidecar listener, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func (r *SidecarListenerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -269,6 +322,8 @@ func (r *SidecarListenerResource) mapListenerToModel(listener *client.ListenerPo
 		model.AdvertisedVersion = types.StringNull()
 	}
 
+	model.TLS = listenerTLSToObject(listener.TLS)
+
 	// Set the ID
 	model.ID = types.StringValue(fmt.Sprintf("%s:%d", model.SidecarID.ValueString(), listener.Port))
 }
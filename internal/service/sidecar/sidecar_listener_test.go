@@ -13,6 +13,7 @@ import (
 	"github.com/altrsoftware/terraform-provider-altr/internal/client"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -75,6 +76,125 @@ func TestAccSidecarListenerResource_withAdvertisedVersion(t *testing.T) {
 	})
 }
 
+func TestAccSidecarListenerResource_updateAdvertisedVersion(t *testing.T) {
+	resourceName := "altr_sidecar_listener.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	rHostname := fmt.Sprintf("%s.example.altr.com", rName)
+	port := sdkacctest.RandIntRange(3000, 9000)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSidecarListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSidecarListenerResourceConfig_withAdvertisedVersion(rName, rHostname, pubKeyExample1, port, "19.0.0.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSidecarListenerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "advertised_version", "19.0.0.0"),
+				),
+			},
+			{
+				Config: testAccSidecarListenerResourceConfig_withAdvertisedVersion(rName, rHostname, pubKeyExample1, port, "21.3.0.0"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSidecarListenerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "advertised_version", "21.3.0.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSidecarListenerResource_advertisedVersionValidation(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	rHostname := fmt.Sprintf("%s.example.altr.com", rName)
+	port := sdkacctest.RandIntRange(3000, 9000)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSidecarListenerResourceConfig_withAdvertisedVersion(rName, rHostname, pubKeyExample1, port, "19c"),
+				ExpectError: regexp.MustCompile(`"19c" is not a valid Oracle version`),
+			},
+		},
+	})
+}
+
+func TestAccSidecarListenerResource_tls(t *testing.T) {
+	resourceName := "altr_sidecar_listener.test"
+	certificateResourceName := "altr_sidecar_certificate.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	rHostname := fmt.Sprintf("%s.example.altr.com", rName)
+	port := sdkacctest.RandIntRange(3000, 9000)
+	certificate, key := testAccSidecarCertificatePEM(t, rHostname)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSidecarListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSidecarListenerResourceConfig_tls(rName, rHostname, pubKeyExample1, port, certificate, key, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSidecarListenerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tls.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tls.required", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tls.min_version", "1.2"),
+					resource.TestCheckResourceAttrPair(resourceName, "tls.certificate_id", certificateResourceName, "id"),
+				),
+			},
+			{
+				Config: testAccSidecarListenerResourceConfig_tls(rName, rHostname, pubKeyExample1, port, certificate, key, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tls.required", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSidecarListenerResource_tlsValidation(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	rHostname := fmt.Sprintf("%s.example.altr.com", rName)
+	port := sdkacctest.RandIntRange(3000, 9000)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSidecarListenerResourceConfig_tlsSettings(rName, rHostname, pubKeyExample1, port, `enabled = true`),
+				ExpectError: regexp.MustCompile(`certificate_id must be set when TLS is enabled`),
+			},
+			{
+				Config:      testAccSidecarListenerResourceConfig_tlsSettings(rName, rHostname, pubKeyExample1, port, "enabled = false\n    required = true"),
+				ExpectError: regexp.MustCompile(`TLS cannot be required when it is not enabled`),
+			},
+			{
+				Config:      testAccSidecarListenerResourceConfig_tlsSettings(rName, rHostname, pubKeyExample1, port, "enabled = false\n    min_version = \"1.1\""),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+		},
+	})
+}
+
 func TestAccSidecarListenerResource_oracle(t *testing.T) {
 	resourceName := "altr_sidecar_listener.test"
 	sidecarResourceName := "altr_sidecar.test"
@@ -412,3 +532,54 @@ resource "altr_sidecar_listener" "test" {
 }
 `, name, hostname, publicKey1, port, databaseType)
 }
+
+func testAccSidecarListenerResourceConfig_tls(name, hostname, publicKey1 string, port int, certificateChain, privateKey string, required bool) string {
+	return fmt.Sprintf(`
+resource "altr_sidecar" "test" {
+  name         = %[1]q
+  hostname     = %[2]q
+  public_key_1 = %[3]q
+}
+
+resource "altr_sidecar_certificate" "test" {
+  sidecar_id        = altr_sidecar.test.id
+  name              = %[1]q
+  certificate_chain = %[5]q
+  private_key_wo    = %[6]q
+}
+
+resource "altr_sidecar_listener" "test" {
+  sidecar_id         = altr_sidecar.test.id
+  port               = %[4]d
+  database_type      = "Postgres"
+  advertised_version = "16.2"
+
+  tls = {
+    enabled        = true
+    required       = %[7]t
+    certificate_id = altr_sidecar_certificate.test.id
+  }
+}
+`, name, hostname, publicKey1, port, certificateChain, privateKey, required)
+}
+
+func testAccSidecarListenerResourceConfig_tlsSettings(name, hostname, publicKey1 string, port int, tls string) string {
+	return fmt.Sprintf(`
+resource "altr_sidecar" "test" {
+  name         = %[1]q
+  hostname     = %[2]q
+  public_key_1 = %[3]q
+}
+
+resource "altr_sidecar_listener" "test" {
+  sidecar_id         = altr_sidecar.test.id
+  port               = %[4]d
+  database_type      = "Postgres"
+  advertised_version = "16.2"
+
+  tls = {
+    %[5]s
+  }
+}
+`, name, hostname, publicKey1, port, tls)
+}
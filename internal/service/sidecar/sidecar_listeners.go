@@ -0,0 +1,466 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sidecar
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/altrsoftware/terraform-provider-altr/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &SidecarListenersResource{}
+	_ resource.ResourceWithImportState    = &SidecarListenersResource{}
+	_ resource.ResourceWithValidateConfig = &SidecarListenersResource{}
+)
+
+func NewSidecarListenersResource() resource.Resource {
+	return &SidecarListenersResource{}
+}
+
+// SidecarListenersResource owns the complete set of listener ports of a sidecar.
+// Ports registered outside of Terraform are deregistered on apply and show up
+// as drift on refresh.
+type SidecarListenersResource struct {
+	client *client.Client
+}
+
+type SidecarListenersResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	SidecarID types.String `tfsdk:"sidecar_id"`
+	Listeners types.Set    `tfsdk:"listeners"`
+}
+
+var listenerAttrTypes = map[string]attr.Type{
+	"port":               types.Int64Type,
+	"database_type":      types.StringType,
+	"advertised_version": types.StringType,
+	"tls":                types.ObjectType{AttrTypes: listenerTLSAttrTypes},
+}
+
+func (r *SidecarListenersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sidecar_listeners"
+}
+
+func (r *SidecarListenersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Authoritatively manages every listener port of a sidecar. Listeners that are not in the configuration, " +
+			"including ones registered outside of Terraform, are deregistered. Do not combine with altr_sidecar_listener " +
+			"resources for the same sidecar.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the listener set (the sidecar ID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sidecar_id": schema.StringAttribute{
+				Description: "ID of the sidecar.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(service.UUIDv4Regex),
+						"must be a valid UUIDv4",
+					),
+				},
+			},
+			"listeners": schema.SetNestedAttribute{
+				Description: "Complete set of listener ports of the sidecar. Each port may appear only once.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						// 1 to 65535
+						"port": schema.Int64Attribute{
+							Description: "Port number for the listener.",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"database_type": schema.StringAttribute{
+							Description: "Type of database: Oracle, MSSQL, MySQL, Postgres, Snowflake, Redshift or Databricks.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(service.DatabaseTypes...),
+							},
+						},
+						"advertised_version": schema.StringAttribute{
+							Description: "Advertised version of the database in the format the database type reports. Changing it updates the listener in place.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 128),
+							},
+						},
+						"tls": listenerTLSSchemaAttribute(),
+					},
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *SidecarListenersResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SidecarListenersResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Listeners.IsNull() || config.Listeners.IsUnknown() {
+		return
+	}
+
+	// Set elements only differ as a whole, so two listeners may share a port
+	seen := make(map[int64]bool)
+
+	for _, element := range config.Listeners.Elements() {
+		listener, ok := element.(types.Object)
+		if !ok || listener.IsUnknown() {
+			continue
+		}
+
+		if tls, ok := listener.Attributes()["tls"].(types.Object); ok {
+			resp.Diagnostics.Append(validateListenerTLS(tls, path.Root("listeners").AtSetValue(listener).AtName("tls"))...)
+		}
+
+		databaseType, _ := listener.Attributes()["database_type"].(types.String)
+		advertisedVersion, _ := listener.Attributes()["advertised_version"].(types.String)
+
+		if !databaseType.IsNull() && !databaseType.IsUnknown() && !advertisedVersion.IsNull() && !advertisedVersion.IsUnknown() {
+			if err := validation.AdvertisedVersion(databaseType.ValueString(), advertisedVersion.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("listeners").AtSetValue(listener).AtName("advertised_version"),
+					"Invalid Advertised Version",
+					err.Error(),
+				)
+			}
+		}
+
+		port, ok := listener.Attributes()["port"].(types.Int64)
+		if !ok || port.IsNull() || port.IsUnknown() {
+			continue
+		}
+
+		if seen[port.ValueInt64()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("listeners"),
+				"Duplicate Listener Port",
+				fmt.Sprintf("Port %d is configured more than once. Each port of a sidecar can have only one listener.", port.ValueInt64()),
+			)
+		}
+
+		seen[port.ValueInt64()] = true
+	}
+}
+
+func (r *SidecarListenersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SidecarListenersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SidecarListenersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Take over the sidecar, registering missing ports and deregistering extras
+	listeners, diags := r.reconcileListeners(plan.SidecarID.ValueString(), listenersFromSet(plan.Listeners))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.SidecarID
+	plan.Listeners = listenersToSet(listeners)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *SidecarListenersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SidecarListenersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listeners, err := r.client.ListSidecarListeners(state.SidecarID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading sidecar listeners",
+			"Could not list listeners of sidecar "+state.SidecarID.ValueString()+": "+err.Error(),
+		)
+
+		return
+	}
+
+	// Listing the ports of a deleted sidecar returns nothing, so tell the two apart
+	if len(listeners) == 0 {
+		sidecar, err := r.client.GetSidecar(state.SidecarID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading sidecar",
+				"Could not read sidecar "+state.SidecarID.ValueString()+": "+err.Error(),
+			)
+
+			return
+		}
+
+		if sidecar == nil {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+	}
+
+	state.ID = state.SidecarID
+	state.Listeners = listenersToSet(listeners)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *SidecarListenersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SidecarListenersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reconcile against the sidecar rather than the prior state so ports changed
+	// since the last refresh are handled too
+	listeners, diags := r.reconcileListeners(plan.SidecarID.ValueString(), listenersFromSet(plan.Listeners))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.SidecarID
+	plan.Listeners = listenersToSet(listeners)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *SidecarListenersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SidecarListenersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, listener := range listenersFromSet(state.Listeners) {
+		err := r.client.DeregisterSidecarListener(state.SidecarID.ValueString(), listener.Port)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deregistering sidecar listener",
+				fmt.Sprintf("Could not deregister listener on port %d, unexpected error: %s", listener.Port, err.Error()),
+			)
+
+			return
+		}
+	}
+}
+
+func (r *SidecarListenersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expected import ID format: "sidecar_id"
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sidecar_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// reconcileListeners makes the listeners of the sidecar match desired and returns
+// the resulting listeners. Changed advertised versions and TLS settings are updated
+// in place, while a port whose database type changed is deregistered and
+// registered again.
+func (r *SidecarListenersResource) reconcileListeners(sidecarID string, desired []client.RegisterSidecarListenerInput) ([]client.ListenerPort, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	current, err := r.client.ListSidecarListeners(sidecarID)
+	if err != nil {
+		diags.AddError(
+			"Error reading sidecar listeners",
+			"Could not list listeners of sidecar "+sidecarID+": "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	desiredByPort := make(map[int]client.RegisterSidecarListenerInput, len(desired))
+	for _, listener := range desired {
+		desiredByPort[listener.Port] = listener
+	}
+
+	currentByPort := make(map[int]client.ListenerPort, len(current))
+
+	// Deregister first so that changed ports are free to be registered again
+	for _, listener := range current {
+		want, ok := desiredByPort[listener.Port]
+		if ok && want.DatabaseType == listener.DatabaseType {
+			currentByPort[listener.Port] = listener
+
+			if want.AdvertisedVersion == listener.AdvertisedVersion && sameListenerTLS(want.TLS, listener.TLS) {
+				continue
+			}
+
+			err := r.client.UpdateSidecarListener(sidecarID, listener.Port, client.UpdateSidecarListenerInput{
+				AdvertisedVersion: want.AdvertisedVersion,
+				TLS:               want.TLS,
+			})
+			if err != nil {
+				diags.AddError(
+					"Error updating sidecar listener",
+					fmt.Sprintf("Could not update listener on port %d, unexpected error: %s", listener.Port, err.Error()),
+				)
+
+				return nil, diags
+			}
+
+			continue
+		}
+
+		if err := r.client.DeregisterSidecarListener(sidecarID, listener.Port); err != nil {
+			diags.AddError(
+				"Error deregistering sidecar listener",
+				fmt.Sprintf("Could not deregister listener on port %d, unexpected error: %s", listener.Port, err.Error()),
+			)
+
+			return nil, diags
+		}
+	}
+
+	for _, listener := range desired {
+		if _, ok := currentByPort[listener.Port]; ok {
+			continue
+		}
+
+		if err := r.client.RegisterSidecarListener(sidecarID, listener); err != nil {
+			diags.AddError(
+				"Error registering sidecar listener",
+				fmt.Sprintf("Could not register listener on port %d, unexpected error: %s", listener.Port, err.Error()),
+			)
+
+			return nil, diags
+		}
+	}
+
+	listeners, err := r.client.ListSidecarListeners(sidecarID)
+	if err != nil {
+		diags.AddError(
+			"Error reading sidecar listeners",
+			"Could not list listeners of sidecar "+sidecarID+": "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return listeners, diags
+}
+
+// Helper function to convert the listeners set to API inputs
+func listenersFromSet(set types.Set) []client.RegisterSidecarListenerInput {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	var listeners []client.RegisterSidecarListenerInput
+
+	for _, element := range set.Elements() {
+		listenerObj, ok := element.(types.Object)
+		if !ok {
+			continue // Skip invalid listeners
+		}
+
+		listenerAttrs := listenerObj.Attributes()
+
+		port, _ := listenerAttrs["port"].(types.Int64)
+		databaseType, _ := listenerAttrs["database_type"].(types.String)
+		advertisedVersion, _ := listenerAttrs["advertised_version"].(types.String)
+		tls, _ := listenerAttrs["tls"].(types.Object)
+
+		listeners = append(listeners, client.RegisterSidecarListenerInput{
+			Port:              int(port.ValueInt64()),
+			DatabaseType:      databaseType.ValueString(),
+			AdvertisedVersion: advertisedVersion.ValueString(),
+			TLS:               listenerTLSFromObject(tls),
+		})
+	}
+
+	return listeners
+}
+
+// Helper function to convert API listeners to the listeners set
+func listenersToSet(listeners []client.ListenerPort) types.Set {
+	sorted := make([]client.ListenerPort, len(listeners))
+	copy(sorted, listeners)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Port < sorted[j].Port })
+
+	elements := make([]attr.Value, 0, len(sorted))
+
+	for _, listener := range sorted {
+		advertisedVersion := types.StringNull()
+		if listener.AdvertisedVersion != "" {
+			advertisedVersion = types.StringValue(listener.AdvertisedVersion)
+		}
+
+		elements = append(elements, types.ObjectValueMust(listenerAttrTypes, map[string]attr.Value{
+			"port":               types.Int64Value(int64(listener.Port)),
+			"database_type":      types.StringValue(listener.DatabaseType),
+			"advertised_version": advertisedVersion,
+			"tls":                listenerTLSToObject(listener.TLS),
+		}))
+	}
+
+	return types.SetValueMust(types.ObjectType{AttrTypes: listenerAttrTypes}, elements)
+}
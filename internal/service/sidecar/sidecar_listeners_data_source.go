@@ -0,0 +1,163 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sidecar
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SidecarListenersDataSource{}
+
+func NewSidecarListenersDataSource() datasource.DataSource {
+	return &SidecarListenersDataSource{}
+}
+
+type SidecarListenersDataSource struct {
+	client *client.Client
+}
+
+type SidecarListenersDataSourceModel struct {
+	SidecarID    types.String                              `tfsdk:"sidecar_id"`
+	DatabaseType types.String                              `tfsdk:"database_type"`
+	Ports        []types.Int64                             `tfsdk:"ports"`
+	Listeners    []SidecarListenersDataSourceListenerModel `tfsdk:"listeners"`
+}
+
+type SidecarListenersDataSourceListenerModel struct {
+	Port              types.Int64  `tfsdk:"port"`
+	DatabaseType      types.String `tfsdk:"database_type"`
+	AdvertisedVersion types.String `tfsdk:"advertised_version"`
+}
+
+func (d *SidecarListenersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sidecar_listeners"
+}
+
+func (d *SidecarListenersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for listing every listener port registered on a sidecar.",
+		Attributes: map[string]schema.Attribute{
+			"sidecar_id": schema.StringAttribute{
+				Description: "ID of the sidecar.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(service.UUIDv4Regex),
+						"must be a valid UUIDv4",
+					),
+				},
+			},
+			"database_type": schema.StringAttribute{
+				Description: "Only return listeners for this type of database (Oracle, MSSQL, MySQL, Postgres, Snowflake, Redshift, Databricks).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(service.DatabaseTypes...),
+				},
+			},
+			"ports": schema.ListAttribute{
+				Description: "Port numbers of the matching listeners, sorted.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+			"listeners": schema.ListNestedAttribute{
+				Description: "Matching listeners, sorted by port.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"port": schema.Int64Attribute{
+							Description: "Port number of the listener.",
+							Computed:    true,
+						},
+						"database_type": schema.StringAttribute{
+							Description: "Type of database (e.g., Oracle, etc.).",
+							Computed:    true,
+						},
+						"advertised_version": schema.StringAttribute{
+							Description: "Advertised version of the database.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SidecarListenersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SidecarListenersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SidecarListenersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get sidecar listeners from API
+	listeners, err := d.client.ListSidecarListeners(config.SidecarID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing sidecar listeners",
+			fmt.Sprintf("Could not list listeners of sidecar %s: %s", config.SidecarID.ValueString(), err.Error()),
+		)
+
+		return
+	}
+
+	sort.Slice(listeners, func(i, j int) bool {
+		return listeners[i].Port < listeners[j].Port
+	})
+
+	config.Ports = []types.Int64{}
+	config.Listeners = []SidecarListenersDataSourceListenerModel{}
+
+	for _, listener := range listeners {
+		if !config.DatabaseType.IsNull() && listener.DatabaseType != config.DatabaseType.ValueString() {
+			continue
+		}
+
+		advertisedVersion := types.StringNull()
+		if listener.AdvertisedVersion != "" {
+			advertisedVersion = types.StringValue(listener.AdvertisedVersion)
+		}
+
+		config.Ports = append(config.Ports, types.Int64Value(int64(listener.Port)))
+		config.Listeners = append(config.Listeners, SidecarListenersDataSourceListenerModel{
+			Port:              types.Int64Value(int64(listener.Port)),
+			DatabaseType:      types.StringValue(listener.DatabaseType),
+			AdvertisedVersion: advertisedVersion,
+		})
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
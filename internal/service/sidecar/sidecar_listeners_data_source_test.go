@@ -0,0 +1,69 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sidecar_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSidecarListenersDataSource_basic(t *testing.T) {
+	dataSourceName := "data.altr_sidecar_listeners.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	rHostname := fmt.Sprintf("%s.example.altr.com", rName)
+	port1 := sdkacctest.RandIntRange(3000, 6000)
+	port2 := sdkacctest.RandIntRange(6001, 9000)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSidecarListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSidecarListenersDataSourceConfig(rName, rHostname, pubKeyExample1, port1, port2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ports.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "ports.0", strconv.Itoa(port1)),
+					resource.TestCheckResourceAttr(dataSourceName, "ports.1", strconv.Itoa(port2)),
+					resource.TestCheckResourceAttr(dataSourceName, "listeners.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "listeners.0.port", strconv.Itoa(port1)),
+					resource.TestCheckResourceAttr(dataSourceName, "listeners.0.database_type", "Oracle"),
+					resource.TestCheckResourceAttr(dataSourceName, "listeners.0.advertised_version", "19.0.0.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "listeners.1.port", strconv.Itoa(port2)),
+					resource.TestCheckResourceAttr("data.altr_sidecar_listeners.oracle", "ports.#", "2"),
+					resource.TestCheckResourceAttr("data.altr_sidecar_listeners.postgres", "ports.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSidecarListenersDataSourceConfig(name, hostname, publicKey1 string, port1, port2 int) string {
+	return testAccSidecarListenerResourceConfig_multiplePorts(name, hostname, publicKey1, port1, port2) + `
+data "altr_sidecar_listeners" "test" {
+  sidecar_id = altr_sidecar.test.id
+
+  depends_on = [altr_sidecar_listener.test1, altr_sidecar_listener.test2]
+}
+
+data "altr_sidecar_listeners" "oracle" {
+  sidecar_id    = altr_sidecar.test.id
+  database_type = "Oracle"
+
+  depends_on = [altr_sidecar_listener.test1, altr_sidecar_listener.test2]
+}
+
+data "altr_sidecar_listeners" "postgres" {
+  sidecar_id    = altr_sidecar.test.id
+  database_type = "Postgres"
+
+  depends_on = [altr_sidecar_listener.test1, altr_sidecar_listener.test2]
+}
+`
+}
@@ -0,0 +1,237 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sidecar_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSidecarListenersResource_basic(t *testing.T) {
+	resourceName := "altr_sidecar_listeners.test"
+	sidecarResourceName := "altr_sidecar.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	rHostname := fmt.Sprintf("%s.example.altr.com", rName)
+	port1 := sdkacctest.RandIntRange(3000, 6000)
+	port2 := sdkacctest.RandIntRange(6001, 9000)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSidecarListenersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSidecarListenersResourceConfig_basic(rName, rHostname, pubKeyExample1, port1, port2, "19.0.0.0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", sidecarResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "sidecar_id", sidecarResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "listeners.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "listeners.*", map[string]string{
+						"port":               strconv.Itoa(port1),
+						"database_type":      "Oracle",
+						"advertised_version": "19.0.0.0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "listeners.*", map[string]string{
+						"port":               strconv.Itoa(port2),
+						"database_type":      "Postgres",
+						"advertised_version": "16.2",
+					}),
+				),
+			},
+			{
+				Config: testAccSidecarListenersResourceConfig_basic(rName, rHostname, pubKeyExample1, port1, port2, "21.0.0.0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "listeners.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "listeners.*", map[string]string{
+						"port":               strconv.Itoa(port1),
+						"advertised_version": "21.0.0.0",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSidecarListenersResource_removesUnmanaged(t *testing.T) {
+	resourceName := "altr_sidecar_listeners.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	rHostname := fmt.Sprintf("%s.example.altr.com", rName)
+	port1 := sdkacctest.RandIntRange(3000, 6000)
+	port2 := sdkacctest.RandIntRange(6001, 9000)
+	unmanagedPort := sdkacctest.RandIntRange(9001, 12000)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSidecarListenersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSidecarListenersResourceConfig_basic(rName, rHostname, pubKeyExample1, port1, port2, "19.0.0.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSidecarListenersRegisterUnmanaged(resourceName, unmanagedPort),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccSidecarListenersResourceConfig_basic(rName, rHostname, pubKeyExample1, port1, port2, "19.0.0.0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "listeners.#", "2"),
+					testAccCheckSidecarListenersPortCount(resourceName, 2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSidecarListenersResource_duplicatePort(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	rHostname := fmt.Sprintf("%s.example.altr.com", rName)
+	port := sdkacctest.RandIntRange(3000, 9000)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSidecarListenersResourceConfig_basic(rName, rHostname, pubKeyExample1, port, port, "19.0.0.0"),
+				ExpectError: regexp.MustCompile(`Duplicate Listener Port`),
+			},
+		},
+	})
+}
+
+func TestAccSidecarListenersResource_advertisedVersionValidation(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	rHostname := fmt.Sprintf("%s.example.altr.com", rName)
+	port := sdkacctest.RandIntRange(3000, 9000)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSidecarListenersResourceConfig_basic(rName, rHostname, pubKeyExample1, port, port+1, "19c"),
+				ExpectError: regexp.MustCompile(`"19c" is not a valid Oracle version`),
+			},
+		},
+	})
+}
+
+func testAccSidecarListenersClient() (*client.Client, error) {
+	return client.NewClient(
+		testGetEnv("ALTR_ORG_ID", "test-org"),
+		testGetEnv("ALTR_API_KEY", "test-key"),
+		testGetEnv("ALTR_SECRET", "test-secret"),
+		testGetEnv("ALTR_BASE_URL", ""),
+	)
+}
+
+func testAccCheckSidecarListenersRegisterUnmanaged(resourceName string, port int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn, err := testAccSidecarListenersClient()
+		if err != nil {
+			return fmt.Errorf("failed to create test client: %w", err)
+		}
+
+		return conn.RegisterSidecarListener(rs.Primary.Attributes["sidecar_id"], client.RegisterSidecarListenerInput{
+			Port:              port,
+			DatabaseType:      "Oracle",
+			AdvertisedVersion: "19.0.0.0",
+		})
+	}
+}
+
+func testAccCheckSidecarListenersPortCount(resourceName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn, err := testAccSidecarListenersClient()
+		if err != nil {
+			return fmt.Errorf("failed to create test client: %w", err)
+		}
+
+		listeners, err := conn.ListSidecarListeners(rs.Primary.Attributes["sidecar_id"])
+		if err != nil {
+			return err
+		}
+
+		if len(listeners) != expected {
+			return fmt.Errorf("expected %d sidecar listeners, got %d", expected, len(listeners))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSidecarListenersDestroy(s *terraform.State) error {
+	conn, err := testAccSidecarListenersClient()
+	if err != nil {
+		return fmt.Errorf("failed to create test client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "altr_sidecar_listeners" {
+			continue
+		}
+
+		listeners, err := conn.ListSidecarListeners(rs.Primary.Attributes["sidecar_id"])
+		if err != nil {
+			return err
+		}
+
+		if len(listeners) > 0 {
+			return fmt.Errorf("Sidecar %s still has %d listeners", rs.Primary.ID, len(listeners))
+		}
+	}
+
+	return nil
+}
+
+func testAccSidecarListenersResourceConfig_basic(name, hostname, publicKey1 string, port1, port2 int, oracleVersion string) string {
+	return fmt.Sprintf(`
+resource "altr_sidecar" "test" {
+  name         = %[1]q
+  hostname     = %[2]q
+  public_key_1 = %[3]q
+}
+
+resource "altr_sidecar_listeners" "test" {
+  sidecar_id = altr_sidecar.test.id
+
+  listeners = [
+    {
+      port               = %[4]d
+      database_type      = "Oracle"
+      advertised_version = %[6]q
+    },
+    {
+      port               = %[5]d
+      database_type      = "Postgres"
+      advertised_version = "16.2"
+    },
+  ]
+}
+`, name, hostname, publicKey1, port1, port2, oracleVersion)
+}
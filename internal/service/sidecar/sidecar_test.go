@@ -14,6 +14,7 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 var pubKeyExample1 = `-----BEGIN PUBLIC KEY-----
@@ -26,6 +27,9 @@ RF5rhiJNZR6dwdftOeHzoQiLHL4r/VsJ7PpMycjdtaVgPnv30JMnkAQTP6m9c+/H
 +wIDAQAB
 -----END PUBLIC KEY-----`
 
+// pubKeyExample1Fingerprint is the SHA-256 digest of the DER encoding of pubKeyExample1
+const pubKeyExample1Fingerprint = "3674c2a4f8cc5fc757b87dc1c8e7be9ad35f7ea1a369a4209894d0a684b85671"
+
 var pubKeyExample2 = `-----BEGIN PUBLIC KEY-----
 MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAzEyEtWdjYF8AnUnbrczr
 FZBarvdu/urMLvGQ6x9D6+AN8RrdHj5GV5+Bp17MHaceZTLlvra1x2LSaXlQhnro
@@ -36,6 +40,18 @@ AdJ3tVlGq0almLUC3+dpY4plkMbI4Dphv/6RaSVYPFklAnnjQzI4eby9cTNUBdeM
 WQIDAQAB
 -----END PUBLIC KEY-----`
 
+var pubKeyRSA1024 = `-----BEGIN PUBLIC KEY-----
+MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQDbMB5guE8iKWuUuSRONuil6Ubz
+mUk5fNIzt8iexlx5RFqleuXYkCdrTSbTcdtuSuhuDzjRxsQLpCtibtHhNCLsEN7u
+pUA3QDGJOIlwowlblGGp1avGI6MF5TG/ZYr4gXHrhRFVNvRAnzW4gLreJsfw/V/j
+ekS+tpldDelPqn9s+wIDAQAB
+-----END PUBLIC KEY-----`
+
+var pubKeyECDSA = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE1a9wDUFluJtvvYBRodcYQiD2xsfL
+3nk8Ls778p6TU58unaMrPJMH8Q5IJK1Wwt1aNGs5Bc0dkA2qWgNlPfFGZA==
+-----END PUBLIC KEY-----`
+
 func TestAccSidecarResource_basic(t *testing.T) {
 	resourceName := "altr_sidecar.test"
 	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
@@ -58,6 +74,8 @@ func TestAccSidecarResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "listener_count", "0"),
 					resource.TestCheckResourceAttr(resourceName, "listener_repo_binding_count", "0"),
 					resource.TestCheckResourceAttr(resourceName, "unsupported_query_bypass", "false"),
+					resource.TestCheckResourceAttr(resourceName, "public_key_1_fingerprint", pubKeyExample1Fingerprint),
+					resource.TestCheckNoResourceAttr(resourceName, "public_key_2_fingerprint"),
 				),
 			},
 			{
@@ -233,6 +251,71 @@ func TestAccSidecarResource_hostnameValidation(t *testing.T) {
 	})
 }
 
+func TestAccSidecarResource_invalidPublicKey(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	rHostname := fmt.Sprintf("%s.example.altr.com", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSidecarResourceConfig_basic(rName, rHostname, "not a key"),
+				ExpectError: regexp.MustCompile(`not a PEM-encoded public key`),
+			},
+			{
+				Config:      testAccSidecarResourceConfig_basic(rName, rHostname, pubKeyExample1[:200]),
+				ExpectError: regexp.MustCompile(`not a PEM-encoded public key`),
+			},
+			{
+				Config:      testAccSidecarResourceConfig_basic(rName, rHostname, pubKeyRSA1024),
+				ExpectError: regexp.MustCompile(`must be at least 2048 bits, got 1024`),
+			},
+			{
+				Config:      testAccSidecarResourceConfig_basic(rName, rHostname, pubKeyECDSA),
+				ExpectError: regexp.MustCompile(`public key must be RSA`),
+			},
+		},
+	})
+}
+
+func TestAccSidecarResource_writeOnlyPublicKey(t *testing.T) {
+	resourceName := "altr_sidecar.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	rHostname := fmt.Sprintf("%s.example.altr.com", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSidecarDestroy,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSidecarResourceConfig_writeOnlyPublicKey(rName, rHostname, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSidecarExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "public_key_1"),
+					resource.TestCheckNoResourceAttr(resourceName, "public_key_1_wo"),
+					resource.TestCheckResourceAttr(resourceName, "public_key_wo_version", "1"),
+				),
+			},
+			{
+				// A new key pair is generated on every run, but it is only sent when the version changes
+				Config:   testAccSidecarResourceConfig_writeOnlyPublicKey(rName, rHostname, 1),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccSidecarResourceConfig_writeOnlyPublicKey(rName, rHostname, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "public_key_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSidecarResource_disappears(t *testing.T) {
 	resourceName := "altr_sidecar.test"
 	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
@@ -353,6 +436,19 @@ resource "altr_sidecar" "test" {
 `, name, hostname, publicKey)
 }
 
+func testAccSidecarResourceConfig_writeOnlyPublicKey(name, hostname string, version int) string {
+	return fmt.Sprintf(`
+ephemeral "altr_registration_keypair" "test" {}
+
+resource "altr_sidecar" "test" {
+  name                  = %[1]q
+  hostname              = %[2]q
+  public_key_1_wo       = ephemeral.altr_registration_keypair.test.public_key_pem
+  public_key_wo_version = %[3]d
+}
+`, name, hostname, version)
+}
+
 func testAccSidecarResourceConfig_withDescription(name, hostname, publicKey, description string) string {
 	return fmt.Sprintf(`
 resource "altr_sidecar" "test" {
@@ -0,0 +1,225 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sidecar
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/client"
+	"github.com/altrsoftware/terraform-provider-altr/internal/service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SidecarsDataSource{}
+
+func NewSidecarsDataSource() datasource.DataSource {
+	return &SidecarsDataSource{}
+}
+
+type SidecarsDataSource struct {
+	client *client.Client
+}
+
+type SidecarsDataSourceModel struct {
+	Name      types.String                     `tfsdk:"name"`
+	NameRegex types.String                     `tfsdk:"name_regex"`
+	Hostname  types.String                     `tfsdk:"hostname"`
+	IDs       []types.String                   `tfsdk:"ids"`
+	Sidecars  []SidecarsDataSourceSidecarModel `tfsdk:"sidecars"`
+}
+
+type SidecarsDataSourceSidecarModel struct {
+	ID                       types.String `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	Description              types.String `tfsdk:"description"`
+	Hostname                 types.String `tfsdk:"hostname"`
+	DataPlaneURL             types.String `tfsdk:"data_plane_url"`
+	ListenerCount            types.Int64  `tfsdk:"listener_count"`
+	ListenerRepoBindingCount types.Int64  `tfsdk:"listener_repo_binding_count"`
+	UnsupportedQueryBypass   types.Bool   `tfsdk:"unsupported_query_bypass"`
+	CreatedAt                types.String `tfsdk:"created_at"`
+	UpdatedAt                types.String `tfsdk:"updated_at"`
+}
+
+func (d *SidecarsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sidecars"
+}
+
+func (d *SidecarsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for listing the sidecars in the organization, optionally filtered. All filters that are set must match.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only return the sidecar with exactly this name.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return sidecars whose name matches this RE2 regular expression.",
+				Optional:    true,
+			},
+			"hostname": schema.StringAttribute{
+				Description: "Only return sidecars with this hostname. Compared case-insensitively, ignoring a trailing dot and differences in IP address spelling.",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "IDs of the matching sidecars, sorted by name.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"sidecars": schema.ListNestedAttribute{
+				Description: "Matching sidecars, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the sidecar.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the sidecar.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the sidecar.",
+							Computed:    true,
+						},
+						"hostname": schema.StringAttribute{
+							Description: "Hostname of the sidecar.",
+							Computed:    true,
+						},
+						"data_plane_url": schema.StringAttribute{
+							Description: "Data plane URL of the sidecar.",
+							Computed:    true,
+						},
+						"listener_count": schema.Int64Attribute{
+							Description: "Number of listeners for this sidecar.",
+							Computed:    true,
+						},
+						"listener_repo_binding_count": schema.Int64Attribute{
+							Description: "Number of listener repo bindings for this sidecar.",
+							Computed:    true,
+						},
+						"unsupported_query_bypass": schema.BoolAttribute{
+							Description: "If true, the sidecar will bypass the query parser and return all results without applying policy.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Creation timestamp.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "Last update timestamp.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SidecarsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SidecarsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SidecarsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !config.NameRegex.IsNull() {
+		var err error
+
+		nameRegex, err = regexp.Compile(config.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Configuration",
+				"name_regex is not a valid regular expression: "+err.Error(),
+			)
+
+			return
+		}
+	}
+
+	// Get sidecars from API
+	sidecars, err := d.client.ListSidecars()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing sidecars",
+			"Could not list sidecars, unexpected error: "+err.Error(),
+		)
+
+		return
+	}
+
+	sort.Slice(sidecars, func(i, j int) bool {
+		return sidecars[i].Name < sidecars[j].Name
+	})
+
+	config.IDs = []types.String{}
+	config.Sidecars = []SidecarsDataSourceSidecarModel{}
+
+	for _, sidecar := range sidecars {
+		if !config.Name.IsNull() && sidecar.Name != config.Name.ValueString() {
+			continue
+		}
+
+		if nameRegex != nil && !nameRegex.MatchString(sidecar.Name) {
+			continue
+		}
+
+		if !config.Hostname.IsNull() && !strings.EqualFold(service.NewHostValue(sidecar.Hostname).NormalizedHost(), service.NewHostValue(config.Hostname.ValueString()).NormalizedHost()) {
+			continue
+		}
+
+		config.IDs = append(config.IDs, types.StringValue(sidecar.ID))
+		config.Sidecars = append(config.Sidecars, SidecarsDataSourceSidecarModel{
+			ID:                       types.StringValue(sidecar.ID),
+			Name:                     types.StringValue(sidecar.Name),
+			Description:              types.StringValue(sidecar.Description),
+			Hostname:                 types.StringValue(sidecar.Hostname),
+			DataPlaneURL:             types.StringValue(sidecar.DataPlaneURL),
+			ListenerCount:            types.Int64Value(int64(sidecar.ListenerCount)),
+			ListenerRepoBindingCount: types.Int64Value(int64(sidecar.ListenerRepoBindingCount)),
+			UnsupportedQueryBypass:   types.BoolValue(sidecar.UnsupportedQueryBypass),
+			CreatedAt:                types.StringValue(sidecar.CreatedAt),
+			UpdatedAt:                types.StringValue(sidecar.UpdatedAt),
+		})
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
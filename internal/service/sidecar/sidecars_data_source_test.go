@@ -0,0 +1,80 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sidecar_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSidecarsDataSource_basic(t *testing.T) {
+	resourceName := "altr_sidecar.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	rHostname := fmt.Sprintf("%s.example.altr.com", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSidecarDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSidecarsDataSourceConfig(rName, rHostname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.altr_sidecars.by_name", "sidecars.#", "1"),
+					resource.TestCheckResourceAttrPair("data.altr_sidecars.by_name", "ids.0", resourceName, "id"),
+					resource.TestCheckResourceAttrPair("data.altr_sidecars.by_name", "sidecars.0.hostname", resourceName, "hostname"),
+					resource.TestCheckResourceAttr("data.altr_sidecars.by_name", "sidecars.0.listener_count", "0"),
+					resource.TestCheckResourceAttr("data.altr_sidecars.by_regex", "sidecars.#", "1"),
+					resource.TestCheckResourceAttrPair("data.altr_sidecars.by_regex", "ids.0", resourceName, "id"),
+					resource.TestCheckResourceAttr("data.altr_sidecars.by_hostname", "sidecars.#", "1"),
+					resource.TestCheckResourceAttrPair("data.altr_sidecars.by_hostname", "ids.0", resourceName, "id"),
+					resource.TestCheckResourceAttr("data.altr_sidecars.none", "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSidecarsDataSource_invalidNameRegex(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "altr_sidecars" "test" {
+  name_regex = "("
+}
+`,
+				ExpectError: regexp.MustCompile(`name_regex is not a valid regular expression`),
+			},
+		},
+	})
+}
+
+func testAccSidecarsDataSourceConfig(name, hostname string) string {
+	return testAccSidecarResourceConfig_basic(name, hostname, pubKeyExample1) + `
+data "altr_sidecars" "by_name" {
+  name = altr_sidecar.test.name
+}
+
+data "altr_sidecars" "by_regex" {
+  name_regex = "^${altr_sidecar.test.name}$"
+}
+
+data "altr_sidecars" "by_hostname" {
+  hostname = upper(altr_sidecar.test.hostname)
+}
+
+data "altr_sidecars" "none" {
+  name     = altr_sidecar.test.name
+  hostname = "not-${altr_sidecar.test.hostname}"
+}
+`
+}
@@ -0,0 +1,46 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// advertisedVersionFormats are the version strings a sidecar listener may advertise
+// for each database type, in the form the database reports its own version
+var advertisedVersionFormats = map[string]struct {
+	pattern *regexp.Regexp
+	example string
+}{
+	// 19.0.0.0 or 19.3.0.0.0
+	"Oracle": {regexp.MustCompile(`^\d+(\.\d+){1,4}$`), "19.0.0.0"},
+	// 15.0.2000.5
+	"MSSQL": {regexp.MustCompile(`^\d+\.\d+(\.\d+){0,2}$`), "15.0.2000.5"},
+	// 8.0.36, optionally with a suffix such as 8.0.36-log
+	"MySQL": {regexp.MustCompile(`^\d+\.\d+(\.\d+)?(-[A-Za-z0-9.]+)?$`), "8.0.36"},
+	// 16, 16.2 or 9.6.24
+	"Postgres": {regexp.MustCompile(`^\d+(\.\d+){0,2}$`), "16.2"},
+	// 8.12.1
+	"Snowflake": {regexp.MustCompile(`^\d+\.\d+(\.\d+)?$`), "8.12.1"},
+	// Redshift advertises the Postgres version it is compatible with
+	"Redshift": {regexp.MustCompile(`^\d+(\.\d+){0,2}$`), "8.0.2"},
+	// Databricks runtime version
+	"Databricks": {regexp.MustCompile(`^\d+\.\d+(\.\d+)?$`), "15.4"},
+}
+
+// AdvertisedVersion checks that version is a valid version string for the database type.
+// Unknown database types are not checked, as the database type is validated separately.
+func AdvertisedVersion(databaseType, version string) error {
+	format, ok := advertisedVersionFormats[databaseType]
+	if !ok {
+		return nil
+	}
+
+	if !format.pattern.MatchString(version) {
+		return fmt.Errorf("%q is not a valid %s version, expected a version such as %s", version, databaseType, format.example)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/validation"
+)
+
+func TestAdvertisedVersion(t *testing.T) {
+	testCases := map[string]struct {
+		databaseType string
+		version      string
+		wantErr      bool
+	}{
+		"oracle": {
+			databaseType: "Oracle",
+			version:      "19.0.0.0",
+		},
+		"oracle-five-components": {
+			databaseType: "Oracle",
+			version:      "19.3.0.0.0",
+		},
+		"oracle-major-only": {
+			databaseType: "Oracle",
+			version:      "19",
+			wantErr:      true,
+		},
+		"oracle-release-name": {
+			databaseType: "Oracle",
+			version:      "19c",
+			wantErr:      true,
+		},
+		"mssql": {
+			databaseType: "MSSQL",
+			version:      "15.0.2000.5",
+		},
+		"mssql-major-only": {
+			databaseType: "MSSQL",
+			version:      "15",
+			wantErr:      true,
+		},
+		"mysql": {
+			databaseType: "MySQL",
+			version:      "8.0.36",
+		},
+		"mysql-suffix": {
+			databaseType: "MySQL",
+			version:      "8.0.36-log",
+		},
+		"mysql-major-only": {
+			databaseType: "MySQL",
+			version:      "8",
+			wantErr:      true,
+		},
+		"postgres-major-only": {
+			databaseType: "Postgres",
+			version:      "16",
+		},
+		"postgres": {
+			databaseType: "Postgres",
+			version:      "16.2",
+		},
+		"postgres-legacy": {
+			databaseType: "Postgres",
+			version:      "9.6.24",
+		},
+		"postgres-too-many-components": {
+			databaseType: "Postgres",
+			version:      "16.2.1.1",
+			wantErr:      true,
+		},
+		"postgres-prefix": {
+			databaseType: "Postgres",
+			version:      "v16",
+			wantErr:      true,
+		},
+		"snowflake": {
+			databaseType: "Snowflake",
+			version:      "8.12.1",
+		},
+		"redshift": {
+			databaseType: "Redshift",
+			version:      "8.0.2",
+		},
+		"databricks": {
+			databaseType: "Databricks",
+			version:      "15.4",
+		},
+		"databricks-label": {
+			databaseType: "Databricks",
+			version:      "15.4 LTS",
+			wantErr:      true,
+		},
+		"unknown-type": {
+			databaseType: "Unknown",
+			version:      "anything",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validation.AdvertisedVersion(tc.databaseType, tc.version)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for %q", tc.version)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ParseCertificateChain parses a PEM-encoded certificate chain, leaf certificate first.
+// Every PEM block must be a certificate.
+func ParseCertificateChain(value string) ([]*x509.Certificate, error) {
+	var certificates []*x509.Certificate
+
+	rest := []byte(strings.TrimSpace(value))
+
+	for len(rest) > 0 {
+		var block *pem.Block
+
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, errors.New("certificate chain contains data that is not PEM-encoded")
+		}
+
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("expected only CERTIFICATE PEM blocks, got %q", block.Type)
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("certificate %d of the chain is invalid: %w", len(certificates)+1, err)
+		}
+
+		certificates = append(certificates, certificate)
+		rest = []byte(strings.TrimSpace(string(rest)))
+	}
+
+	if len(certificates) == 0 {
+		return nil, errors.New("certificate chain must contain at least one PEM-encoded certificate")
+	}
+
+	return certificates, nil
+}
+
+// CertificateKeyPair checks that the private key is a PEM-encoded key matching the
+// leaf certificate of the chain
+func CertificateKeyPair(chain, privateKey string) error {
+	if _, err := tls.X509KeyPair([]byte(chain), []byte(privateKey)); err != nil {
+		return fmt.Errorf("private key does not match the certificate chain: %w", err)
+	}
+
+	return nil
+}
+
+// CertificateChainValidator validates that a string is a PEM-encoded certificate chain
+type CertificateChainValidator struct{}
+
+// Description returns a description of the validator
+func (v CertificateChainValidator) Description(_ context.Context) string {
+	return "Ensures the value is a PEM-encoded X.509 certificate chain"
+}
+
+// MarkdownDescription returns a markdown description of the validator
+func (v CertificateChainValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation
+func (v CertificateChainValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := ParseCertificateChain(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Certificate Chain",
+			err.Error(),
+		)
+	}
+}
+
+// CertificateChain creates a new certificate chain validator
+func CertificateChain() validator.String {
+	return CertificateChainValidator{}
+}
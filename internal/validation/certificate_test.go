@@ -0,0 +1,126 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/validation"
+)
+
+// testCertificate returns a self-signed PEM certificate and its PEM private key
+func testCertificate(t *testing.T, commonName string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %s", err)
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshaling key: %s", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}))
+}
+
+func TestParseCertificateChain(t *testing.T) {
+	leaf, leafKey := testCertificate(t, "sidecar.example.com")
+	intermediate, _ := testCertificate(t, "Example Intermediate CA")
+
+	testCases := map[string]struct {
+		value     string
+		wantCount int
+		wantErr   bool
+	}{
+		"leaf": {
+			value:     leaf,
+			wantCount: 1,
+		},
+		"chain": {
+			value:     leaf + intermediate,
+			wantCount: 2,
+		},
+		"surrounding-whitespace": {
+			value:     "\n" + leaf + "\n\n" + intermediate + "\n",
+			wantCount: 2,
+		},
+		"empty": {
+			value:   "",
+			wantErr: true,
+		},
+		"not-pem": {
+			value:   "not a certificate",
+			wantErr: true,
+		},
+		"private-key-in-chain": {
+			value:   leaf + leafKey,
+			wantErr: true,
+		},
+		"trailing-garbage": {
+			value:   leaf + "garbage",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := validation.ParseCertificateChain(tc.value)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %d certificates", len(got))
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != tc.wantCount {
+				t.Errorf("expected %d certificates, got %d", tc.wantCount, len(got))
+			}
+		})
+	}
+}
+
+func TestCertificateKeyPair(t *testing.T) {
+	certificate, key := testCertificate(t, "sidecar.example.com")
+	_, otherKey := testCertificate(t, "other.example.com")
+
+	if err := validation.CertificateKeyPair(certificate, key); err != nil {
+		t.Errorf("unexpected error for matching key: %s", err)
+	}
+
+	if err := validation.CertificateKeyPair(certificate, otherKey); err == nil {
+		t.Error("expected error for a key of another certificate")
+	}
+
+	if err := validation.CertificateKeyPair(certificate, "not a key"); err == nil {
+		t.Error("expected error for a key that is not PEM-encoded")
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// MaxHostnameLength is the longest DNS name accepted, excluding a trailing dot
+const MaxHostnameLength = 253
+
+// NormalizeHost validates a repository or sidecar host and returns its canonical form.
+// Accepted hosts are RFC 1123 DNS names, IPv4 addresses and IPv6 addresses with or
+// without brackets. DNS names are lowercased and lose a trailing dot; IP addresses are
+// returned in their RFC 5952 text form without brackets or zones.
+func NormalizeHost(value string) (string, error) {
+	if value == "" {
+		return "", errors.New("host must not be empty")
+	}
+
+	if strings.HasPrefix(value, "[") || strings.HasSuffix(value, "]") {
+		inner, ok := strings.CutPrefix(value, "[")
+		if ok {
+			inner, ok = strings.CutSuffix(inner, "]")
+		}
+
+		if !ok {
+			return "", fmt.Errorf("host %q has unbalanced brackets", value)
+		}
+
+		addr, err := netip.ParseAddr(inner)
+		if err != nil || !addr.Is6() {
+			return "", fmt.Errorf("host %q: only IPv6 addresses may be enclosed in brackets", value)
+		}
+
+		return canonicalIP(addr, value)
+	}
+
+	if addr, err := netip.ParseAddr(value); err == nil {
+		return canonicalIP(addr, value)
+	}
+
+	if strings.Contains(value, ":") {
+		return "", fmt.Errorf("host %q is not a valid IPv6 address", value)
+	}
+
+	name := strings.TrimSuffix(value, ".")
+	if len(name) > MaxHostnameLength {
+		return "", fmt.Errorf("hostname must be at most %d characters, got %d", MaxHostnameLength, len(name))
+	}
+
+	labels := strings.Split(name, ".")
+	for _, label := range labels {
+		if err := validateHostnameLabel(label); err != nil {
+			return "", fmt.Errorf("host %q is not a valid hostname: %w", value, err)
+		}
+	}
+
+	// A dotted all-numeric name is a mistyped IPv4 address, not a hostname
+	if isNumeric(labels[len(labels)-1]) {
+		return "", fmt.Errorf("host %q is neither a valid IPv4 address nor a valid hostname", value)
+	}
+
+	return strings.ToLower(name), nil
+}
+
+func canonicalIP(addr netip.Addr, value string) (string, error) {
+	if addr.Zone() != "" {
+		return "", fmt.Errorf("host %q must not include an IPv6 zone", value)
+	}
+
+	return addr.String(), nil
+}
+
+func validateHostnameLabel(label string) error {
+	if label == "" {
+		return errors.New("labels must not be empty")
+	}
+
+	if len(label) > 63 {
+		return fmt.Errorf("label %q is longer than 63 characters", label)
+	}
+
+	if label[0] == '-' || label[len(label)-1] == '-' {
+		return fmt.Errorf("label %q must not start or end with a hyphen", label)
+	}
+
+	for _, r := range label {
+		if !isAlphanumeric(r) && r != '-' {
+			return fmt.Errorf("label %q may only contain letters, digits and hyphens", label)
+		}
+	}
+
+	return nil
+}
+
+func isAlphanumeric(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+}
+
+func isNumeric(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HostValidator validates that a string is a hostname, IPv4 address or IPv6 address
+type HostValidator struct{}
+
+// Description returns a description of the validator
+func (v HostValidator) Description(_ context.Context) string {
+	return "Ensures the value is an RFC 1123 hostname, an IPv4 address or an IPv6 address, optionally in brackets"
+}
+
+// MarkdownDescription returns a markdown description of the validator
+func (v HostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation
+func (v HostValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := NormalizeHost(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			err.Error(),
+		)
+	}
+}
+
+// Host creates a new host validator
+func Host() validator.String {
+	return HostValidator{}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/validation"
+)
+
+func TestNormalizeHost(t *testing.T) {
+	testCases := map[string]struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		"hostname": {
+			value: "db.example.com",
+			want:  "db.example.com",
+		},
+		"hostname-mixed-case-trailing-dot": {
+			value: "DB.Example.COM.",
+			want:  "db.example.com",
+		},
+		"single-label": {
+			value: "oracle-primary",
+			want:  "oracle-primary",
+		},
+		"ipv4": {
+			value: "10.0.12.7",
+			want:  "10.0.12.7",
+		},
+		"ipv6": {
+			value: "2001:DB8:0:0:0:0:0:1",
+			want:  "2001:db8::1",
+		},
+		"ipv6-bracketed": {
+			value: "[2001:db8::1]",
+			want:  "2001:db8::1",
+		},
+		"ipv4-mapped-ipv6": {
+			value: "[::ffff:10.0.12.7]",
+			want:  "::ffff:10.0.12.7",
+		},
+		"empty": {
+			value:   "",
+			wantErr: true,
+		},
+		"trailing-dot-only": {
+			value:   ".",
+			wantErr: true,
+		},
+		"empty-label": {
+			value:   "db..example.com",
+			wantErr: true,
+		},
+		"leading-hyphen": {
+			value:   "-db.example.com",
+			wantErr: true,
+		},
+		"underscore": {
+			value:   "db_1.example.com",
+			wantErr: true,
+		},
+		"label-too-long": {
+			value:   strings.Repeat("a", 64) + ".example.com",
+			wantErr: true,
+		},
+		"name-too-long": {
+			value:   strings.Repeat(strings.Repeat("a", 63)+".", 4) + "com",
+			wantErr: true,
+		},
+		"ipv4-out-of-range": {
+			value:   "10.0.12.300",
+			wantErr: true,
+		},
+		"ipv4-bracketed": {
+			value:   "[10.0.12.7]",
+			wantErr: true,
+		},
+		"ipv6-unbalanced-bracket": {
+			value:   "[2001:db8::1",
+			wantErr: true,
+		},
+		"ipv6-invalid": {
+			value:   "2001:db8:::1",
+			wantErr: true,
+		},
+		"ipv6-zone": {
+			value:   "fe80::1%eth0",
+			wantErr: true,
+		},
+		"host-with-port": {
+			value:   "db.example.com:1521",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := validation.NormalizeHost(tc.value)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got result %q", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// MinRSAPublicKeyBits is the smallest RSA modulus accepted for sidecar and agent public keys
+const MinRSAPublicKeyBits = 2048
+
+// ParseRSAPublicKey decodes a PEM-encoded PKIX (-----BEGIN PUBLIC KEY-----) RSA public key
+// and returns the key together with its DER encoding
+func ParseRSAPublicKey(value string) (*rsa.PublicKey, []byte, error) {
+	block, rest := pem.Decode([]byte(strings.TrimSpace(value)))
+	if block == nil {
+		return nil, nil, errors.New("value is not a PEM-encoded public key")
+	}
+
+	if len(strings.TrimSpace(string(rest))) > 0 {
+		return nil, nil, errors.New("value must contain exactly one PEM block")
+	}
+
+	if block.Type != "PUBLIC KEY" {
+		return nil, nil, fmt.Errorf("PEM block type must be %q, got %q", "PUBLIC KEY", block.Type)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not parse public key: %w", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("public key must be RSA, got %T", key)
+	}
+
+	if rsaKey.N.BitLen() < MinRSAPublicKeyBits {
+		return nil, nil, fmt.Errorf("RSA public key must be at least %d bits, got %d", MinRSAPublicKeyBits, rsaKey.N.BitLen())
+	}
+
+	return rsaKey, block.Bytes, nil
+}
+
+// PublicKeyFingerprint returns the lowercase hex SHA-256 digest of the DER-encoded public key,
+// matching `openssl pkey -pubin -outform DER | sha256sum`
+func PublicKeyFingerprint(value string) (string, error) {
+	_, der, err := ParseRSAPublicKey(value)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(der)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// RSAPublicKeyValidator validates that a string is a PEM-encoded RSA public key of an acceptable size
+type RSAPublicKeyValidator struct{}
+
+// Description returns a description of the validator
+func (v RSAPublicKeyValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Ensures the value is a PEM-encoded PKIX RSA public key of at least %d bits", MinRSAPublicKeyBits)
+}
+
+// MarkdownDescription returns a markdown description of the validator
+func (v RSAPublicKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation
+func (v RSAPublicKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, _, err := ParseRSAPublicKey(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Public Key",
+			err.Error(),
+		)
+	}
+}
+
+// RSAPublicKey creates a new RSA public key validator
+func RSAPublicKey() validator.String {
+	return RSAPublicKeyValidator{}
+}
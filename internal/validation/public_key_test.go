@@ -0,0 +1,215 @@
+// Copyright (c) ALTR Solutions, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/altrsoftware/terraform-provider-altr/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const pubKeyExample1 = `-----BEGIN PUBLIC KEY-----
+MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAp/TpUtMCmMLzQ+vTzuel
+XudSzqzsgjEj7dWrpNgY+fwo8r6oVx19pDbeNATlCMrmQM942aGmL2kdBhhPrZuC
+0ImfaLjQxqHgXrNEqis7C+mlm9B0NK3LXp8x+FvIuE92z0L9fw/kH9bsicDCh4QQ
+W0Amk6rR8Gc2qWwJfwSz+6H/fCfPd9fPsAQ+oeaB4yf8lqeQcbdbCTmGWkpWQ1I6
+5yzMizbmwrj7/G44drPSpKahrY3OpUNSiweVCrQ9bxd3Eu/UIgr2CIvG1bYtt1b9
+RF5rhiJNZR6dwdftOeHzoQiLHL4r/VsJ7PpMycjdtaVgPnv30JMnkAQTP6m9c+/H
++wIDAQAB
+-----END PUBLIC KEY-----`
+
+// pubKeyExample1Fingerprint is the SHA-256 digest of the DER encoding of pubKeyExample1
+const pubKeyExample1Fingerprint = "3674c2a4f8cc5fc757b87dc1c8e7be9ad35f7ea1a369a4209894d0a684b85671"
+
+// testPublicKey returns the PEM PKIX encoding of a public key
+func testPublicKey(t *testing.T, key crypto.PublicKey) string {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatalf("marshaling public key: %s", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+// testPublicKeys returns PEM public keys that are too small to be accepted or are not RSA
+func testPublicKeys(t *testing.T) (string, string) {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("generating RSA key: %s", err)
+	}
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating ECDSA key: %s", err)
+	}
+
+	return testPublicKey(t, &rsaKey.PublicKey), testPublicKey(t, &ecdsaKey.PublicKey)
+}
+
+func TestParseRSAPublicKey(t *testing.T) {
+	rsa1024, ecdsaKey := testPublicKeys(t)
+
+	testCases := map[string]struct {
+		value   string
+		wantErr bool
+	}{
+		"rsa-2048": {
+			value: pubKeyExample1,
+		},
+		"surrounding-whitespace": {
+			value: "\n  " + pubKeyExample1 + "\n\n",
+		},
+		"rsa-1024": {
+			value:   rsa1024,
+			wantErr: true,
+		},
+		"ecdsa": {
+			value:   ecdsaKey,
+			wantErr: true,
+		},
+		"not-pem": {
+			value:   "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQ",
+			wantErr: true,
+		},
+		"wrong-block-type": {
+			value:   "-----BEGIN RSA PUBLIC KEY-----\nMAA=\n-----END RSA PUBLIC KEY-----",
+			wantErr: true,
+		},
+		"two-blocks": {
+			value:   pubKeyExample1 + "\n" + pubKeyExample1,
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			key, der, err := validation.ParseRSAPublicKey(tc.value)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %d-bit key", key.N.BitLen())
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if key.N.BitLen() < validation.MinRSAPublicKeyBits || len(der) == 0 {
+				t.Fatalf("unexpected result: %d-bit key, %d DER bytes", key.N.BitLen(), len(der))
+			}
+		})
+	}
+}
+
+func TestPublicKeyFingerprint(t *testing.T) {
+	_, ecdsaKey := testPublicKeys(t)
+
+	testCases := map[string]struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		"known-key": {
+			value: pubKeyExample1,
+			want:  pubKeyExample1Fingerprint,
+		},
+		"line-endings-differ": {
+			value: "-----BEGIN PUBLIC KEY-----\r\n" + pubKeyExample1[len("-----BEGIN PUBLIC KEY-----\n"):],
+			want:  pubKeyExample1Fingerprint,
+		},
+		"ecdsa": {
+			value:   ecdsaKey,
+			wantErr: true,
+		},
+		"not-pem": {
+			value:   "not a key",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := validation.PublicKeyFingerprint(tc.value)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got result %q", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != tc.want {
+				t.Fatalf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRSAPublicKey(t *testing.T) {
+	rsa1024, ecdsaKey := testPublicKeys(t)
+
+	testCases := map[string]struct {
+		value   types.String
+		wantErr bool
+	}{
+		"valid": {
+			value: types.StringValue(pubKeyExample1),
+		},
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+		"rsa-1024": {
+			value:   types.StringValue(rsa1024),
+			wantErr: true,
+		},
+		"ecdsa": {
+			value:   types.StringValue(ecdsaKey),
+			wantErr: true,
+		},
+		"not-pem": {
+			value:   types.StringValue("not a key"),
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("public_key"),
+				ConfigValue: tc.value,
+			}
+			resp := &validator.StringResponse{}
+
+			validation.RSAPublicKey().ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error %t, got diagnostics %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}